@@ -0,0 +1,74 @@
+package val
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// ValidateAll validates every item using up to workers goroutines, or one per
+// CPU when workers is less than one. The returned slice holds the result of
+// Validate for each item in the same order as items, nil for valid ones. If ctx
+// is done before every item has been handed to a worker ValidateAll stops early
+// and returns ctx.Err(), items that were never reached are left nil.
+//
+//	results, err := val.ValidateAll(ctx, items, 8)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	for i, err := range results {
+//	    if err != nil {
+//	        fmt.Println(i, err)
+//	    }
+//	}
+func ValidateAll[T any](ctx context.Context, items []T, workers int) ([]error, error) {
+
+	results := make([]error, len(items))
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				results[i] = Validate(&items[i])
+			}
+		}()
+	}
+
+	var err error
+
+	for i := range items {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return results, err
+}
@@ -0,0 +1,97 @@
+package val
+
+import (
+	"context"
+	"strconv"
+	"testing"
+)
+
+type testBatchItem struct {
+	Name  *string `json:"name" validate:"required|length_between:1,10"`
+	Count *int    `json:"count" validate:"min:1"`
+}
+
+func batchFactory(n int) []testBatchItem {
+
+	items := make([]testBatchItem, n)
+
+	for i := range items {
+		name := "item" + strconv.Itoa(i)
+		count := i % 5
+
+		items[i].Name = &name
+		items[i].Count = &count
+	}
+
+	return items
+}
+
+// Run with -race to check that the plan cache is safe to share.
+func TestValidateAll(t *testing.T) {
+
+	items := batchFactory(1000)
+
+	results, err := ValidateAll(context.Background(), items, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != len(items) {
+		t.Fatalf("Expected %d results but got %d.", len(items), len(results))
+	}
+
+	// Every fifth item has a count of 0 and fails min:1.
+	for i, err := range results {
+		if (i%5 == 0) != (err != nil) {
+			t.Errorf("Item %d returned %v which does not match its input.", i, err)
+		}
+	}
+
+	pointers := []*testBatchItem{&items[1], nil}
+
+	results, _ = ValidateAll(context.Background(), pointers, 0)
+	if results[0] != nil || results[1] == nil {
+		t.Errorf("Expected only the nil item to fail but got %v.", results)
+	}
+}
+
+func TestValidateAllCancelled(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := ValidateAll(ctx, batchFactory(100), 4)
+	if err != context.Canceled {
+		t.Errorf("A cancelled context should return context.Canceled but got %v.", err)
+	}
+
+	for i, err := range results {
+		if err != nil {
+			t.Errorf("Item %d should not have been validated but returned %v.", i, err)
+		}
+	}
+}
+
+// Build plans for the same types from many goroutines at once.
+func TestPlanConcurrent(t *testing.T) {
+
+	type testPlan struct {
+		Name  *string `json:"name" validate:"required"`
+		Inner struct {
+			Email *string `json:"email" validate:"email"`
+		} `json:"inner"`
+	}
+
+	items := make([]testPlan, 200)
+
+	results, err := ValidateAll(context.Background(), items, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, err := range results {
+		if errs, ok := err.(Errors); !ok || len(errs) != 1 || errs[0].Field != "name" {
+			t.Fatalf("Expected a required error for name but got %v.", err)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package val
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+)
+
+// Layouts tried in order when a string needs to become a time.Time.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// Fill the fields of a struct from a source that only knows about strings such
+// as a query string or form. Keys come from the first of tags set on a field
+// and lookup returns every value found for a key. Fields without a value are
+// left alone and conversion failures are returned as field errors.
+func bindValues(value reflect.Value, path string, tags []string, lookup func(key string) ([]string, bool)) Errors {
+
+	var errs Errors
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, tagged := fieldName(field, tags)
+
+		// Embedded structs are flattened in to the parent.
+		if field.Anonymous && !tagged && field.Type.Kind() == reflect.Struct {
+			errs = append(errs, bindValues(value.Field(i), path, tags, lookup)...)
+			continue
+		}
+
+		// Headers and path parameters are never taken from the body.
+		if _, ok := sourceName(field); ok || skipped(field, tags) {
+			continue
+		}
+
+		raw, ok := lookup(name)
+		if !ok || len(raw) == 0 {
+			continue
+		}
+
+		if err := setValue(value.Field(i), raw); err != nil {
+			errs = append(errs, &FieldError{Field: joinPath(path, name), Err: err})
+		}
+	}
+
+	return errs
+}
+
+// Report whether the first of tags set on a field asks for it to be ignored.
+func skipped(field reflect.StructField, tags []string) bool {
+
+	for _, tag := range tags {
+		if value := field.Tag.Get(tag); value != "" {
+			return value == "-"
+		}
+	}
+
+	return false
+}
+
+// Convert raw into the type held by value and store it. Slices take every
+// value passed in, everything else only looks at the first.
+func setValue(value reflect.Value, raw []string) error {
+
+	typ := value.Type()
+
+	if typ.Kind() == reflect.Ptr {
+		ptr := reflect.New(typ.Elem())
+		if err := setValue(ptr.Elem(), raw); err != nil {
+			return err
+		}
+		value.Set(ptr)
+		return nil
+	}
+
+	if typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(typ, len(raw), len(raw))
+		for i := range raw {
+			if err := setValue(slice.Index(i), raw[i:i+1]); err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+		return nil
+	}
+
+	return setString(value, raw[0])
+}
+
+// Convert a single string into the type held by value and store it.
+func setString(value reflect.Value, s string) error {
+
+	typ := value.Type()
+
+	switch {
+	case typ == timeType:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				value.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return conversionError(s, "a time")
+	case typ == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return conversionError(s, "a duration")
+		}
+		value.SetInt(int64(d))
+		return nil
+	case reflect.PtrTo(typ).Implements(textUnmarshalerType) && value.CanAddr():
+		return value.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		value.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return conversionError(s, "a bool")
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return conversionError(s, "an int")
+		}
+		value.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return conversionError(s, "an unsigned int")
+		}
+		value.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return conversionError(s, "a float")
+		}
+		value.SetFloat(f)
+	case reflect.Slice:
+		// Only []byte makes it here, take the string as is.
+		value.SetBytes([]byte(s))
+	default:
+		return errors.New("Values can not be converted to " + typ.String() + ".")
+	}
+
+	return nil
+}
+
+func conversionError(s, to string) error {
+	return errors.New("The value " + strconv.Quote(s) + " could not be converted to " + to + ".")
+}
+
+// Bind with one of the string based binders and then validate the result.
+// Fields that failed to convert are only reported once.
+func bindAndValidate(obj interface{}, tags []string, lookup func(key string) ([]string, bool)) error {
+
+	value, err := settable(obj)
+	if err != nil {
+		return err
+	}
+
+	errs := bindValues(value, "", tags, lookup)
+
+	return merge(errs, validateStruct(value, "", tags)).err()
+}
+
+// Like indirect but also requires that the struct can be written to,
+// meaning obj must have been passed in as a pointer.
+func settable(obj interface{}) (reflect.Value, error) {
+
+	value, err := indirect(obj)
+	if err != nil {
+		return value, err
+	}
+
+	if !value.CanSet() {
+		return value, &InvalidInputError{Kind: value.Kind(), Type: value.Type()}
+	}
+
+	return value, nil
+}
+
+// Append the errors in more to errs skipping any field that already has one.
+func merge(errs, more Errors) Errors {
+
+	seen := make(map[string]bool, len(errs))
+	for _, err := range errs {
+		seen[err.Field] = true
+	}
+
+	for _, err := range more {
+		if !seen[err.Field] {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
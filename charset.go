@@ -0,0 +1,59 @@
+package val
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Allowed runes for each of the character set rules.
+var charsets = map[string]func(rune) bool{
+	"alpha": unicode.IsLetter,
+	"alphanumeric": func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	},
+	"alphadash": func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+	},
+	"ascii": func(r rune) bool {
+		return r <= unicode.MaxASCII
+	},
+	"printable_ascii": func(r rune) bool {
+		return r >= ' ' && r <= '~'
+	},
+	"numeric": unicode.IsDigit,
+	"lowercase": func(r rune) bool {
+		return !unicode.IsUpper(r)
+	},
+	"uppercase": func(r rune) bool {
+		return !unicode.IsLower(r)
+	},
+	"no_whitespace": func(r rune) bool {
+		return !unicode.IsSpace(r)
+	},
+}
+
+// Check that every rune of a string is allowed by the character set rule
+// named in field. Empty strings pass, use filled to reject them.
+func charset(field string, value interface{}) error {
+
+	allowed := charsets[field]
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for " + strings.ToUpper(field) + " could not be converted to a string.")
+	}
+
+	if !utf8.ValidString(data) {
+		return errors.New("The value passed in was not valid UTF-8.")
+	}
+
+	for _, r := range data {
+		if !allowed(r) {
+			return errors.New("The value passed in contained " + string(r) + " which is not allowed by " + field + ".")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package val
+
+import (
+	"testing"
+)
+
+func TestCharsetRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"alpha", "abc", true},
+		{"alpha", "Ünïcödé", true},
+		{"alpha", "日本語", true},
+		{"alpha", "", true},
+		{"alpha", "abc123!!", false},
+		{"alpha", "abc def", false},
+		{"alphanumeric", "abc123", true},
+		{"alphanumeric", "ñ٣", true},
+		{"alphanumeric", "abc123!!", false},
+		{"alphanumeric", "a-b", false},
+		{"alphadash", "user_name-1", true},
+		{"alphadash", "user name", false},
+		{"alphadash", "user.name", false},
+		{"ascii", "hello\n", true},
+		{"ascii", "héllo", false},
+		{"printable_ascii", "hello world~", true},
+		{"printable_ascii", "hello\n", false},
+		{"numeric", "0123456789", true},
+		{"numeric", "12.5", false},
+		{"numeric", "-1", false},
+		{"lowercase", "abc123 é", true},
+		{"lowercase", "abC", false},
+		{"uppercase", "ABC123 É", true},
+		{"uppercase", "ABc", false},
+		{"no_whitespace", "a-b_c", true},
+		{"no_whitespace", "a b", false},
+		{"no_whitespace", "a b", false},
+		{"alpha", "ab\xffc", false},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+
+	number := 5
+	if err := check(testField, "alpha", &number, &number, testParent); err == nil {
+		t.Error("alpha on an int should return an error.")
+	}
+}
@@ -0,0 +1,168 @@
+package val
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Check that no two elements of a slice are equal. With unique:Field the
+// elements are structs and only the named field, by Go name or json tag,
+// has to be distinct. Elements where that field is nil are skipped.
+func unique(field string, value interface{}) error {
+
+	data, ok := sliceValue(value)
+	if !ok {
+		return errors.New("The value passed in for UNIQUE was not a slice.")
+	}
+
+	key := ""
+	if i := strings.Index(field, ":"); i >= 0 {
+		key = field[i+1:]
+	}
+
+	seen := make(map[interface{}]bool, data.Len())
+
+	for i := 0; i < data.Len(); i++ {
+		element := elementValue(data.Index(i))
+
+		if key != "" {
+			if element.Kind() != reflect.Struct {
+				return errors.New("The value passed in for UNIQUE was not a slice of structs.")
+			}
+
+			keyed, found := siblingField(element, key)
+			if !found {
+				return errors.New("The elements passed in for UNIQUE have no field " + key + ".")
+			}
+			// A nil key, or one behind a nil embedded pointer, is not
+			// set and so can not clash with another element.
+			if element = elementValue(keyed); !element.IsValid() {
+				continue
+			}
+		}
+
+		// Check the value rather than its type, an interface holding a
+		// slice has a comparable type but would panic as a map key.
+		if !element.IsValid() || !element.Comparable() {
+			return errors.New("The elements passed in for UNIQUE could not be compared.")
+		}
+
+		item := element.Interface()
+
+		if seen[item] {
+			return errors.New("The value passed in has a duplicate at index " + strconv.Itoa(i) + ".")
+		}
+		seen[item] = true
+	}
+
+	return nil
+}
+
+// Check that the elements of a slice of strings or numbers are in
+// ascending order, equal neighbours are allowed.
+func sorted(field string, value interface{}) error {
+	return ordered(value, "SORTED", 1, "ascending")
+}
+
+// Check that the elements of a slice of strings or numbers are in
+// descending order, equal neighbours are allowed.
+func sorted_desc(field string, value interface{}) error {
+	return ordered(value, "SORTED DESC", -1, "descending")
+}
+
+// Check that every element of a slice is one of the values passed in.
+func subset_of(field string, value interface{}) error {
+
+	data, ok := sliceValue(value)
+	if !ok {
+		return errors.New("The value passed in for SUBSET OF was not a slice.")
+	}
+
+	options := params(field)
+
+	for i := 0; i < data.Len(); i++ {
+		found, ok := member(options, data.Index(i).Interface(), false)
+		if !ok {
+			return errors.New("The elements passed in for SUBSET OF could not be converted to strings or numbers.")
+		}
+
+		if !found {
+			return errors.New("The value passed in at index " + strconv.Itoa(i) + " is not one of the expected values.")
+		}
+	}
+
+	return nil
+}
+
+// Fail when any neighbouring elements compare as the opposite of the
+// direction passed in, 1 for ascending and -1 for descending.
+func ordered(value interface{}, name string, direction int, order string) error {
+
+	data, ok := sliceValue(value)
+	if !ok {
+		return errors.New("The value passed in for " + name + " was not a slice.")
+	}
+
+	for i := 1; i < data.Len(); i++ {
+		c, ok := compareElements(data.Index(i-1), data.Index(i))
+		if !ok {
+			return errors.New("The elements passed in for " + name + " could not be compared.")
+		}
+
+		if c == direction {
+			return errors.New("The value passed in is not in " + order + " order at index " + strconv.Itoa(i) + ".")
+		}
+	}
+
+	return nil
+}
+
+// Compare two elements of the same string or numeric kind, through any
+// pointers, returning -1, 0 or 1 like cmp.Compare.
+func compareElements(a, b reflect.Value) (int, bool) {
+
+	a, b = elementValue(a), elementValue(b)
+
+	if !a.IsValid() || !b.IsValid() || a.Kind() != b.Kind() {
+		return 0, false
+	}
+
+	switch a.Kind() {
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cmp.Compare(a.Uint(), b.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float()), true
+	}
+
+	return 0, false
+}
+
+// Follow the pointers and interfaces holding an element of a slice,
+// returning the zero Value for a nil one.
+func elementValue(element reflect.Value) reflect.Value {
+
+	for element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface {
+		element = element.Elem()
+	}
+
+	return element
+}
+
+// Read a slice or array through any pointers.
+func sliceValue(value interface{}) (reflect.Value, bool) {
+
+	data := indirectValue(value)
+
+	if data.Kind() != reflect.Slice && data.Kind() != reflect.Array {
+		return data, false
+	}
+
+	return data, true
+}
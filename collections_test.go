@@ -0,0 +1,146 @@
+package val
+
+import (
+	"testing"
+)
+
+func TestCollectionRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value interface{}
+		valid bool
+	}{
+		{"unique", []string{"go", "rust"}, true},
+		{"unique", []string{"go", "rust", "go"}, false},
+		{"unique", []int{1, 2, 3}, true},
+		{"unique", [3]int{1, 2, 1}, false},
+		{"unique", []interface{}{1, "1"}, true},
+		{"unique", []interface{}{[]int{1}}, false},
+		{"unique", [][]int{{1}}, false},
+		{"unique", []struct{ Any interface{} }{{[]int{1}}}, false},
+		{"unique", []struct{ Any interface{} }{{1}, {2}}, true},
+		{"unique", []map[string]int{{"a": 1}}, false},
+		{"unique", []string{}, true},
+		{"sorted", []int{1, 2, 2, 3}, true},
+		{"sorted", []int{1, 3, 2}, false},
+		{"sorted", []string{"a", "b", "c"}, true},
+		{"sorted", []string{"b", "a"}, false},
+		{"sorted", []float64{-1.5, 0, 2.25}, true},
+		{"sorted", []uint{2, 1}, false},
+		{"sorted", []bool{true, false}, false},
+		{"sorted", []interface{}{1, "2"}, false},
+		{"sorted", []int{7}, true},
+		{"sorted_desc", []int{3, 2, 2, 1}, true},
+		{"sorted_desc", []int{1, 2}, false},
+		{"subset_of:go,rust,zig", []string{"go", "zig"}, true},
+		{"subset_of:go,rust,zig", []string{"go", "java"}, false},
+		{"subset_of:1,2,3", []int{3, 1}, true},
+		{"subset_of:1,2,3", []int{4}, false},
+		{"subset_of:a", []bool{true}, false},
+		{"unique", "go", false},
+		{"sorted", 12, false},
+		{"subset_of:a", "a", false},
+	}
+
+	for _, test := range tests {
+		err := check(testField, test.rule, test.value, test.value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %v returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestUniqueField(t *testing.T) {
+
+	type testTag struct {
+		Name  string `json:"tag_name"`
+		Color string `json:"color"`
+	}
+
+	type testPost struct {
+		Tags     []testTag  `json:"tags" validate:"unique:Name"`
+		Pointers []*testTag `json:"pointers" validate:"unique:tag_name"`
+		Versions []int      `json:"versions" validate:"unique|sorted"`
+		Labels   []string   `json:"labels" validate:"subset_of:bug,feature,docs"`
+	}
+
+	var test testPost
+
+	body := `{
+		"tags": [{"tag_name": "go", "color": "blue"}, {"tag_name": "rust", "color": "blue"}],
+		"pointers": [{"tag_name": "go"}, {"tag_name": "rust"}],
+		"versions": [1, 2, 5],
+		"labels": ["bug", "docs"]
+	}`
+
+	if err := Bind(jsonFactory(body), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testPost
+
+	body = `{
+		"tags": [{"tag_name": "go", "color": "blue"}, {"tag_name": "go", "color": "red"}],
+		"pointers": [{"tag_name": "go"}, {"tag_name": "go"}],
+		"versions": [1, 2, 2],
+		"labels": ["bug", "question"]
+	}`
+
+	if errs, ok := Bind(jsonFactory(body), &test2).(Errors); !ok || len(errs) != 4 {
+		t.Errorf("Every field should fail but got %v.", errs)
+	}
+
+	ints := []int{1, 2}
+
+	if err := check(testField, "unique:Name", &ints, ints, testParent); err == nil {
+		t.Error("unique:Name should reject a slice that does not hold structs.")
+	}
+
+	type testAny struct {
+		Key  interface{}
+		Name *string
+	}
+
+	anys := []testAny{{Key: []int{1}}, {Key: []int{1}}}
+
+	if err := check(testField, "unique:Key", &anys, anys, testParent); err == nil {
+		t.Error("unique should reject keys holding values that can not be compared.")
+	}
+
+	first, second := "go", "go"
+	names := []testAny{{Name: &first}, {Name: &second}}
+
+	if err := check(testField, "unique:Name", &names, names, testParent); err == nil {
+		t.Error("unique should compare the values pointer keys point to.")
+	}
+
+	type testBase struct {
+		ID *int
+	}
+
+	type testEmbedded struct {
+		*testBase
+		Name string
+	}
+
+	id := 1
+	embedded := []testEmbedded{{Name: "a"}, {Name: "b"}, {testBase: &testBase{ID: &id}}}
+
+	if err := check(testField, "unique:ID", &embedded, embedded, testParent); err != nil {
+		t.Errorf("Keys behind a nil embedded pointer should be skipped but got %v.", err)
+	}
+
+	embedded = append(embedded, testEmbedded{testBase: &testBase{ID: &id}})
+
+	if err := check(testField, "unique:ID", &embedded, embedded, testParent); err == nil {
+		t.Error("Two embedded keys of 1 should fail unique.")
+	}
+
+	tags := []testTag{{Name: "go"}}
+
+	if err := check(testField, "unique:Missing", &tags, tags, testParent); err == nil {
+		t.Error("unique should reject a field the elements do not have.")
+	}
+}
@@ -0,0 +1,250 @@
+package val
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Check that a string or number is none of the values passed in.
+func not_in(field string, value interface{}) error {
+
+	found, ok := member(params(field), value, folded(field))
+	if !ok {
+		return errors.New("The value passed in for NOT IN could not be converted to a string or number.")
+	}
+
+	if found {
+		return errors.New("The value passed in is not allowed.")
+	}
+
+	return nil
+}
+
+// Check that a string is one of the values passed in ignoring case.
+func in_fold(field string, value interface{}) error {
+
+	if _, ok := stringValue(value); !ok {
+		return errors.New("The value passed in for IN FOLD could not be converted to a string.")
+	}
+
+	if found, _ := member(params(field), value, true); !found {
+		return errors.New("In did not match any of the expected values.")
+	}
+
+	return nil
+}
+
+// Look for a string, int or float, including named types such as
+// type Role string, in the options of a rule. Numbers are compared by
+// value so in:1.50 matches 1.5, floats at the precision of their type.
+// Ok is false when the value is not a kind that can be compared.
+func member(options []string, value interface{}, fold bool) (found, ok bool) {
+
+	data := indirectValue(value)
+
+	switch data.Kind() {
+	case reflect.String:
+		for _, option := range options {
+			if equal(data.String(), option, fold) {
+				return true, true
+			}
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, _, _ := ratValue(data.Interface())
+
+		for _, option := range options {
+			if o, ok := new(big.Rat).SetString(option); ok && o.Cmp(n) == 0 {
+				return true, true
+			}
+		}
+
+	case reflect.Float32, reflect.Float64:
+		for _, option := range options {
+			if o, err := strconv.ParseFloat(option, data.Type().Bits()); err == nil && o == data.Float() {
+				return true, true
+			}
+		}
+
+	default:
+		return false, false
+	}
+
+	return false, true
+}
+
+// Check that a string holds every value passed in as a substring, or that
+// a slice holds every value passed in as an element.
+func contains(field string, value interface{}) error {
+
+	found, err := containing(field, value)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range params(field) {
+		if !found(option) {
+			return errors.New("The value passed in does not contain " + option + ".")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string or slice holds at least one of the values passed in.
+func contains_any(field string, value interface{}) error {
+
+	found, err := containing(field, value)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range params(field) {
+		if found(option) {
+			return nil
+		}
+	}
+
+	return errors.New("The value passed in does not contain any of the expected values.")
+}
+
+// Check that a string or slice holds none of the values passed in.
+func excludes(field string, value interface{}) error {
+
+	found, err := containing(field, value)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range params(field) {
+		if found(option) {
+			return errors.New("The value passed in must not contain " + option + ".")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string starts with one of the values passed in.
+func starts_with(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for STARTS WITH could not be converted to a string.")
+	}
+
+	fold := folded(field)
+
+	for _, prefix := range params(field) {
+		if len(data) >= len(prefix) && equal(data[:len(prefix)], prefix, fold) {
+			return nil
+		}
+	}
+
+	return errors.New("The value passed in does not start with any of the expected values.")
+}
+
+// Check that a string ends with one of the values passed in.
+func ends_with(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ENDS WITH could not be converted to a string.")
+	}
+
+	fold := folded(field)
+
+	for _, suffix := range params(field) {
+		if len(data) >= len(suffix) && equal(data[len(data)-len(suffix):], suffix, fold) {
+			return nil
+		}
+	}
+
+	return errors.New("The value passed in does not end with any of the expected values.")
+}
+
+// Build a lookup for the contains family. Strings are searched for
+// substrings and slices or arrays of strings and ints for elements.
+func containing(field string, value interface{}) (func(string) bool, error) {
+
+	fold := folded(field)
+	data := indirectValue(value)
+
+	switch data.Kind() {
+	case reflect.String:
+		text := data.String()
+		if fold {
+			text = strings.ToLower(text)
+		}
+
+		return func(option string) bool {
+			if fold {
+				option = strings.ToLower(option)
+			}
+			return strings.Contains(text, option)
+		}, nil
+
+	case reflect.Slice, reflect.Array:
+		elements := make([]string, 0, data.Len())
+
+		for i := 0; i < data.Len(); i++ {
+			element, ok := scalarString(data.Index(i))
+			if !ok {
+				return nil, errors.New("The elements passed in for CONTAINS could not be converted to strings.")
+			}
+			elements = append(elements, element)
+		}
+
+		return func(option string) bool {
+			for _, element := range elements {
+				if equal(element, option, fold) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	return nil, errors.New("The value passed in for CONTAINS was not a string or slice.")
+}
+
+// Format a string or integer, through any pointers, for comparing against
+// a rule parameter.
+func scalarString(value reflect.Value) (string, bool) {
+
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", false
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), true
+	}
+
+	return "", false
+}
+
+// The _fold variants of a rule, such as in_fold:, compare ignoring case.
+func folded(field string) bool {
+	return strings.HasSuffix(field[:strings.Index(field, ":")], "_fold")
+}
+
+func equal(a, b string, fold bool) bool {
+
+	if fold {
+		return strings.EqualFold(a, b)
+	}
+
+	return a == b
+}
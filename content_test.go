@@ -0,0 +1,111 @@
+package val
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContentRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"not_in:root,admin", "michael", true},
+		{"not_in:root,admin", "root", false},
+		{"not_in:root,admin", "Root", true},
+		{"not_in_fold:root,admin", "Root", false},
+		{"in_fold:admin,user", "ADMIN", true},
+		{"in_fold:admin,user", "guest", false},
+		{"contains:@", "m@example.com", true},
+		{"contains:@,.", "m@example", false},
+		{"contains_fold:EXAMPLE", "m@example.com", true},
+		{"contains_any:!,?", "hello?", true},
+		{"contains_any:!,?", "hello", false},
+		{"excludes:<,>", "hello", true},
+		{"excludes:<,>", "<b>hello", false},
+		{"excludes_fold:drop", "DROP table", false},
+		{"starts_with:https://,http://", "https://example.com", true},
+		{"starts_with:https://", "ftp://example.com", false},
+		{"starts_with_fold:sk_", "SK_live", true},
+		{"ends_with:.png,.jpg", "cat.jpg", true},
+		{"ends_with:.png", "png", false},
+		{"ends_with_fold:.PNG", "cat.png", true},
+		{`in:a\,b,c`, "a,b", true},
+		{`in:a\,b,c`, "a", false},
+		{`contains:\,`, "a,b", true},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestContainsSlice(t *testing.T) {
+
+	tags := []string{"go", "Rust"}
+	ids := []int{1, 2, 3}
+
+	if err := check(testField, "contains:go,Rust", &tags, tags, testParent); err != nil {
+		t.Error(err)
+	}
+
+	if err := check(testField, "contains:rust", &tags, tags, testParent); err == nil {
+		t.Error("contains should compare slice elements exactly.")
+	}
+
+	if err := check(testField, "contains_fold:rust", &tags, tags, testParent); err != nil {
+		t.Error(err)
+	}
+
+	if err := check(testField, "contains:r", &tags, tags, testParent); err == nil {
+		t.Error("contains should not match part of a slice element.")
+	}
+
+	if err := check(testField, "excludes:4", &ids, ids, testParent); err != nil {
+		t.Error(err)
+	}
+
+	if err := check(testField, "contains_any:4,3", &ids, ids, testParent); err != nil {
+		t.Error(err)
+	}
+
+	floats := []float64{1.5}
+
+	if err := check(testField, "contains:1.5", &floats, floats, testParent); err == nil {
+		t.Error("contains should reject slices it cannot compare.")
+	}
+}
+
+func TestEscapedRules(t *testing.T) {
+
+	type testEscaped struct {
+		Separator *string `json:"separator" validate:"required|in:\\,,\\|,;"`
+	}
+
+	field, _ := reflect.TypeOf(testEscaped{}).FieldByName("Separator")
+
+	if got := rules(field); len(got) != 2 || got[1] != `in:\,,|,;` {
+		t.Errorf("An escaped pipe should not split rules but got %q.", got)
+	}
+
+	for _, separator := range []string{",", "|", ";"} {
+		var test testEscaped
+
+		if err := Bind(jsonFactory(`{"separator": "`+separator+`"}`), &test); err != nil {
+			t.Error(err)
+		}
+	}
+
+	var test testEscaped
+
+	if err := Bind(jsonFactory(`{"separator": "\\,"}`), &test); err == nil {
+		t.Error("The escape should not be part of the allowed value.")
+	}
+}
@@ -0,0 +1,102 @@
+package val
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"unicode"
+)
+
+// Check that a string is an email address using net/mail. Flags can be passed
+// after a colon, e.g. email:strict,idn.
+//
+//	display_name  allow an address with a name such as "Michael <m@example.com>"
+//	idn           allow internationalised (unicode) domain names
+//	strict        require a fully qualified domain, a plain dot-atom local part
+//	              and the length limits from RFC 5321
+func email(field string, value interface{}) error {
+
+	var flags []string
+	if i := strings.Index(field, ":"); i >= 0 {
+		flags = strings.Split(field[i+1:], ",")
+	}
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for EMAIL could not be converted to a string.")
+	}
+
+	// An empty string is left for required or filled to deal with.
+	if data == "" {
+		return nil
+	}
+
+	address, err := mail.ParseAddress(data)
+	if err != nil {
+		return errors.New("The value passed in was not a valid email address.")
+	}
+
+	if (address.Name != "" || strings.HasSuffix(strings.TrimSpace(data), ">")) && !hasFlag(flags, "display_name") {
+		return errors.New("The email address passed in should not include a name.")
+	}
+
+	at := strings.LastIndex(address.Address, "@")
+	local, domain := address.Address[:at], address.Address[at+1:]
+
+	if !validDomain(domain, hasFlag(flags, "idn")) {
+		return errors.New("The email address passed in did not have a valid domain.")
+	}
+
+	if hasFlag(flags, "strict") {
+		if len(local) > 64 || len(address.Address) > 254 || !dotAtom(local) || !strings.Contains(domain, ".") {
+			return errors.New("The value passed in was not a valid email address.")
+		}
+	}
+
+	return nil
+}
+
+// Report whether domain is made of RFC 1123 labels. With idn set labels can
+// also hold unicode letters and digits.
+func validDomain(domain string, idn bool) bool {
+
+	if !idn {
+		return validHostname(domain)
+	}
+
+	if len(domain) == 0 || len(domain) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) == 0 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Report whether a local part is an RFC 5322 dot-atom, without quoting.
+func dotAtom(local string) bool {
+
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+
+		for _, r := range atom {
+			if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
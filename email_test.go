@@ -0,0 +1,71 @@
+package val
+
+import (
+	"testing"
+)
+
+func TestEmailRule(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"email", "michaeljs@gmail.com", true},
+		{"email", "first.last+tag@sub.example.co.uk", true},
+		{"email", "m@localhost", true},
+		{"email", "michaeljs.edu", false},
+		{"email", "m@", false},
+		{"email", "m@bad_domain.com", false},
+		{"email", "Michael <m@gmail.com>", false},
+		{"email:display_name", "Michael <m@gmail.com>", true},
+		{"email", "m@bücher.de", false},
+		{"email:idn", "m@bücher.de", true},
+		{"email:strict", "m@localhost", false},
+		{"email:strict", `"quoted local"@example.com`, false},
+		{"email", `"quoted local"@example.com`, true},
+		{"email:strict", "m@example.com", true},
+		{"email:strict,idn", "m@bücher.de", true},
+		{"email", "", true},
+		{"email:strict", "", true},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestOptionalEmptyEmailAndURL(t *testing.T) {
+
+	type testContact struct {
+		Email   string  `json:"email" validate:"email"`
+		Website *string `json:"website" validate:"url"`
+	}
+
+	var test testContact
+
+	if err := Bind(jsonFactory(`{"email": "", "website": ""}`), &test); err != nil {
+		t.Errorf("Empty values without required should pass but got %v.", err)
+	}
+
+	var test2 struct {
+		Email *string `json:"email" validate:"required|email"`
+	}
+
+	if err := Bind(jsonFactory(`{"email": ""}`), &test2); err != nil {
+		t.Errorf("required only checks the pointer so an empty email should pass but got %v.", err)
+	}
+
+	var test3 struct {
+		Email *string `json:"email" validate:"filled|email"`
+	}
+
+	if err := Bind(jsonFactory(`{"email": ""}`), &test3); err == nil {
+		t.Error("filled should still reject an empty email.")
+	}
+}
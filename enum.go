@@ -0,0 +1,62 @@
+package val
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Check that a value is one of those its type lists, so a field can be
+// checked with validate:"enum" rather than repeating the constants in an
+// in: rule. The type must be comparable and have a method, on the value or
+// the pointer, of the form func() []T where T is the type itself.
+//
+//	type Role string
+//
+//	const (
+//		Admin Role = "admin"
+//		Guest Role = "guest"
+//	)
+//
+//	func (Role) Values() []Role { return []Role{Admin, Guest} }
+func enum(field string, value interface{}) error {
+
+	data := indirectValue(value)
+	if !data.IsValid() {
+		return errors.New("The value passed in for ENUM was nil.")
+	}
+
+	values, ok := enumValues(data)
+	if !ok {
+		name := data.Type().String()
+		return errors.New("The value passed in for ENUM is of type " + name + " which needs a method Values() []" + name + " to be used as an enum.")
+	}
+
+	for i := 0; i < values.Len(); i++ {
+		if values.Index(i).Interface() == data.Interface() {
+			return nil
+		}
+	}
+
+	return errors.New("The value passed in was not one of the allowed " + data.Type().Name() + " values.")
+}
+
+// Call the Values method of an enum, which must take nothing and return a
+// slice of the enum's own type.
+func enumValues(data reflect.Value) (reflect.Value, bool) {
+
+	method := data.MethodByName("Values")
+	if !method.IsValid() && data.CanAddr() {
+		method = data.Addr().MethodByName("Values")
+	}
+
+	if !method.IsValid() || !data.Type().Comparable() {
+		return reflect.Value{}, false
+	}
+
+	typ := method.Type()
+	if typ.NumIn() != 0 || typ.NumOut() != 1 || typ.Out(0) != reflect.SliceOf(data.Type()) {
+		return reflect.Value{}, false
+	}
+
+	return method.Call(nil)[0], true
+}
@@ -0,0 +1,90 @@
+package val
+
+import (
+	"strings"
+	"testing"
+)
+
+type testRole string
+
+const (
+	testAdmin testRole = "admin"
+	testGuest testRole = "guest"
+)
+
+func (testRole) Values() []testRole { return []testRole{testAdmin, testGuest} }
+
+type testLevel int
+
+func (*testLevel) Values() []testLevel { return []testLevel{1, 2, 3} }
+
+func TestEnum(t *testing.T) {
+
+	type testAccount struct {
+		Role  *testRole  `json:"role" validate:"required|enum"`
+		Level testLevel  `json:"level" validate:"enum"`
+		Other *testLevel `json:"other" validate:"enum"`
+	}
+
+	var test testAccount
+
+	if err := Bind(jsonFactory(`{"role": "admin", "level": 2, "other": 3}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testAccount
+
+	if errs, ok := Bind(jsonFactory(`{"role": "root", "level": 4, "other": 0}`), &test2).(Errors); !ok || len(errs) != 3 {
+		t.Errorf("Every field should fail but got %v.", errs)
+	}
+}
+
+type testWrongEnum string
+
+func (testWrongEnum) Values() []string { return []string{"admin"} }
+
+func TestEnumWithoutValues(t *testing.T) {
+
+	value := "admin"
+
+	if err := check(testField, "enum", &value, value, testParent); err == nil {
+		t.Error("A type without a Values method should not pass enum.")
+	}
+
+	wrong := testWrongEnum("admin")
+
+	err := check(testField, "enum", &wrong, wrong, testParent)
+	if err == nil || !strings.Contains(err.Error(), "Values() []val.testWrongEnum") {
+		t.Errorf("A Values method of the wrong type should name the expected signature but got %v.", err)
+	}
+}
+
+func TestInKinds(t *testing.T) {
+
+	type testIn struct {
+		Int   *int      `json:"int" validate:"in:1,3,2"`
+		Uint  uint8     `json:"uint" validate:"in:1,3,2"`
+		Float *float64  `json:"float" validate:"in:0.1,1.50"`
+		Small float32   `json:"small" validate:"in:0.1"`
+		Role  *testRole `json:"role" validate:"in:admin,guest"`
+		Level testLevel `json:"level" validate:"not_in:0,9"`
+	}
+
+	var test testIn
+
+	if err := Bind(jsonFactory(`{"int": 3, "uint": 2, "float": 1.5, "small": 0.1, "role": "guest", "level": 1}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testIn
+
+	if errs, ok := Bind(jsonFactory(`{"int": 6, "uint": 4, "float": 0.2, "small": 0.2, "role": "root", "level": 9}`), &test2).(Errors); !ok || len(errs) != 6 {
+		t.Errorf("Every field should fail but got %v.", errs)
+	}
+
+	flag := true
+
+	if err := check(testField, "in:true", &flag, flag, testParent); err == nil {
+		t.Error("in should reject kinds it cannot compare.")
+	}
+}
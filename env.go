@@ -0,0 +1,138 @@
+package val
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+// BindEnv fills obj from environment variables and then validates it so that
+// a misconfigured deploy can fail at startup with every bad variable listed.
+// Fields are read from the variable named in their env tag and converted to
+// the field's type, slices are split on commas. Nested structs can add a
+// prefix to the variables of their fields with the envPrefix tag. Errors are
+// named after the variable, e.g. DB_PORT. Variables that are set but empty
+// are treated as unset.
+//
+//	var Config struct {
+//	    Port     *int `env:"PORT" validate:"required|min:1"`
+//	    Database struct {
+//	        Host  *string  `env:"HOST" validate:"required"`
+//	        Hosts []string `env:"REPLICAS"`
+//	    } `envPrefix:"DB_"`
+//	}
+//
+//	if err := val.BindEnv(&Config); err != nil {
+//	    log.Fatal(err)
+//	}
+func BindEnv(obj interface{}) error {
+	return BindEnvLookup(obj, os.LookupEnv)
+}
+
+// BindEnvLookup is BindEnv reading variables through lookup instead of
+// os.LookupEnv, which is mostly useful in tests.
+func BindEnvLookup(obj interface{}, lookup func(key string) (string, bool)) error {
+
+	value, err := settable(obj)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]string)
+	errs := bindEnv(value, "", "", lookup, names)
+
+	// Validation names fields by their path in the struct, report
+	// them by the variable they were read from instead.
+	validation := validateStruct(value, "", []string{"env"})
+	for _, err := range validation {
+		if name, ok := names[err.Field]; ok {
+			err.Field = name
+		}
+	}
+
+	return merge(errs, validation).err()
+}
+
+// Fill the fields of a struct from the environment. Names maps the path
+// validateStruct will use for each field to the variable it was read from.
+func bindEnv(value reflect.Value, path, prefix string, lookup func(key string) (string, bool), names map[string]string) Errors {
+
+	var errs Errors
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, tagged := fieldName(field, []string{"env"})
+		fieldValue := value.Field(i)
+
+		if !tagged && envStruct(field.Type) {
+
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					fieldValue.Set(reflect.New(field.Type.Elem()))
+				}
+				fieldValue = fieldValue.Elem()
+			}
+
+			nestedPath := joinPath(path, name)
+			if field.Anonymous {
+				nestedPath = path
+			}
+
+			errs = append(errs, bindEnv(fieldValue, nestedPath, prefix+field.Tag.Get("envPrefix"), lookup, names)...)
+			continue
+		}
+
+		if !tagged {
+			continue
+		}
+
+		key := prefix + name
+		names[joinPath(path, name)] = key
+
+		raw, ok := lookup(key)
+		if !ok || raw == "" {
+			continue
+		}
+
+		values := []string{raw}
+		if typ := indirectType(field.Type); typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8 {
+			values = strings.Split(raw, ",")
+			for i := range values {
+				values[i] = strings.TrimSpace(values[i])
+			}
+		}
+
+		if err := setValue(fieldValue, values); err != nil {
+			errs = append(errs, &FieldError{Field: key, Err: err})
+		}
+	}
+
+	return errs
+}
+
+// Report whether a field holds a struct whose fields should be bound rather
+// than a value such as time.Time that is converted from a single variable.
+func envStruct(typ reflect.Type) bool {
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ.Kind() == reflect.Struct && typ != timeType && !reflect.PtrTo(typ).Implements(textUnmarshalerType)
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
@@ -0,0 +1,90 @@
+package val
+
+import (
+	"testing"
+	"time"
+)
+
+// Build a lookup function for BindEnvLookup from a map.
+func envFactory(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+type testEnvDatabase struct {
+	Host     *string  `env:"HOST" validate:"required"`
+	Port     int      `env:"PORT" validate:"min:1|max:65535"`
+	Replicas []string `env:"REPLICAS"`
+}
+
+type testEnvConfig struct {
+	Name     *string         `env:"NAME" validate:"required"`
+	Debug    bool            `env:"DEBUG"`
+	Timeout  time.Duration   `env:"TIMEOUT"`
+	Database testEnvDatabase `envPrefix:"DB_"`
+	Cache    *struct {
+		Size *int `env:"SIZE" validate:"min:1"`
+	} `envPrefix:"CACHE_"`
+}
+
+func TestBindEnv(t *testing.T) {
+
+	var testConfig testEnvConfig
+
+	env := envFactory(map[string]string{
+		"NAME":        "api",
+		"DEBUG":       "true",
+		"TIMEOUT":     "5s",
+		"DB_HOST":     "localhost",
+		"DB_PORT":     "5432",
+		"DB_REPLICAS": "a, b,c",
+		"CACHE_SIZE":  "10",
+	})
+
+	if err := BindEnvLookup(&testConfig, env); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testConfig.Name != "api" || !testConfig.Debug || testConfig.Timeout != 5*time.Second {
+		t.Error("Top level variables were not bound.")
+	}
+
+	if *testConfig.Database.Host != "localhost" || testConfig.Database.Port != 5432 || *testConfig.Cache.Size != 10 {
+		t.Error("Prefixed variables were not bound to the nested structs.")
+	}
+
+	if len(testConfig.Database.Replicas) != 3 || testConfig.Database.Replicas[1] != "b" {
+		t.Errorf("DB_REPLICAS should be split on commas but got %v.", testConfig.Database.Replicas)
+	}
+}
+
+func TestBindEnvErrors(t *testing.T) {
+
+	var testConfig testEnvConfig
+
+	env := envFactory(map[string]string{
+		"NAME":       "",
+		"DEBUG":      "maybe",
+		"DB_PORT":    "70000",
+		"CACHE_SIZE": "0",
+	})
+
+	errs, ok := BindEnvLookup(&testConfig, env).(Errors)
+	if !ok {
+		t.Fatalf("Expected Errors but got %v.", errs)
+	}
+
+	expected := []string{"DEBUG", "NAME", "DB_HOST", "DB_PORT", "CACHE_SIZE"}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected errors for %v but got %v.", expected, errs)
+	}
+
+	for i, name := range expected {
+		if errs[i].Field != name {
+			t.Errorf("Expected an error for %s but got %v.", name, errs[i])
+		}
+	}
+}
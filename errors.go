@@ -0,0 +1,82 @@
+package val
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// InvalidInputError is returned when Validate or one of the Bind functions is
+// handed something it can not walk, such as a nil pointer or a value that is
+// not a struct. Kind and Type describe what was actually received after
+// following any pointers and interfaces.
+type InvalidInputError struct {
+	Kind reflect.Kind
+	Type reflect.Type
+}
+
+func (e *InvalidInputError) Error() string {
+	switch {
+	case e.Type == nil:
+		return "Expected a struct but nil was passed in."
+	case e.Kind == reflect.Ptr || e.Kind == reflect.Interface:
+		return "Expected a struct but a nil " + e.Type.String() + " was passed in."
+	case e.Kind == reflect.Struct:
+		return "Expected a pointer to " + e.Type.String() + " so that it could be filled in."
+	}
+
+	return "Expected a struct but a " + e.Kind.String() + " (" + e.Type.String() + ") was passed in."
+}
+
+// FieldError describes a single field that failed to bind or validate. Field
+// is the path to the value as the client named it, Rule is the assertion
+// that failed and is empty when the value could not be converted at all.
+// Field is empty when the error is about the input as a whole.
+// Line is set when binding a file format that can report where a key is.
+type FieldError struct {
+	Field string
+	Rule  string
+	Err   error
+	Line  int
+}
+
+func (e *FieldError) Error() string {
+	message := e.Err.Error()
+
+	if e.Field != "" {
+		message = e.Field + ": " + message
+	}
+
+	if e.Line > 0 {
+		message = "line " + strconv.Itoa(e.Line) + ": " + message
+	}
+
+	return message
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors is returned when one or more fields fail, with one entry per field.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Return errors as an error, or nil when there are none so callers
+// never end up holding a non-nil interface with an empty slice in it.
+func (e Errors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
@@ -0,0 +1,296 @@
+package val
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Length of an IBAN for every country in the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20,
+	"LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20,
+	"MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24,
+	"SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+	"YE": 30,
+}
+
+// Active ISO 4217 currency codes including funds and precious metals.
+var currencies = codeSet(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+	BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE
+	CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD
+	HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD
+	KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV
+	MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB
+	RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT
+	TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF
+	XAG XAU XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW
+	ZWG ZWL
+`)
+
+// Turn a whitespace separated list of codes in to a set.
+func codeSet(codes string) map[string]bool {
+
+	set := make(map[string]bool)
+
+	for _, code := range strings.Fields(codes) {
+		set[code] = true
+	}
+
+	return set
+}
+
+// Check that a string of digits passes the Luhn checksum.
+func luhn(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for LUHN could not be converted to a string.")
+	}
+
+	if len(data) < 2 || !allDigits(data) || !luhnValid(data) {
+		return errors.New("The value passed in did not pass the Luhn check.")
+	}
+
+	return nil
+}
+
+// Check that a string is a card number, 12 to 19 digits that pass the Luhn
+// checksum. Spaces and hyphens between digits are ignored.
+func credit_card(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for CREDIT CARD could not be converted to a string.")
+	}
+
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(data)
+
+	if len(digits) < 12 || len(digits) > 19 || !allDigits(digits) || !luhnValid(digits) {
+		return errors.New("The value passed in was not a valid card number.")
+	}
+
+	return nil
+}
+
+func luhnValid(digits string) bool {
+
+	sum := 0
+	double := false
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// Check that a string is an IBAN with the right length for its country and a
+// valid mod 97 check. Spaces are ignored and letters may be any case.
+func iban(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for IBAN could not be converted to a string.")
+	}
+
+	code := strings.ToUpper(strings.ReplaceAll(data, " ", ""))
+
+	if len(code) < 4 || ibanLengths[code[:2]] != len(code) {
+		return errors.New("The value passed in was not a valid IBAN.")
+	}
+
+	// Move the country and check digits to the end and read the whole thing as
+	// a number with A as 10 through Z as 35, keeping the remainder as we go.
+	remainder := 0
+
+	for _, c := range code[4:] + code[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return errors.New("The value passed in was not a valid IBAN.")
+		}
+	}
+
+	if remainder != 1 {
+		return errors.New("The IBAN passed in did not pass its check digits.")
+	}
+
+	return nil
+}
+
+// Check that a string is a BIC (SWIFT code), a four letter bank code, two
+// letter country, two character location and an optional three character branch.
+func bic(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for BIC could not be converted to a string.")
+	}
+
+	if len(data) != 8 && len(data) != 11 {
+		return errors.New("The value passed in was not a valid BIC.")
+	}
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		letter := 'A' <= c && c <= 'Z'
+		digit := '0' <= c && c <= '9'
+
+		if (i < 6 && !letter) || (i >= 6 && !letter && !digit) {
+			return errors.New("The value passed in was not a valid BIC.")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is an ISBN-10 with a valid check digit. Hyphens and
+// spaces are ignored.
+func isbn10(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ISBN10 could not be converted to a string.")
+	}
+
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(data)
+
+	if len(digits) != 10 || !allDigits(digits[:9]) {
+		return errors.New("The value passed in was not a valid ISBN-10.")
+	}
+
+	sum := 0
+
+	for i := 0; i < 10; i++ {
+		d := int(digits[i] - '0')
+
+		if i == 9 && (digits[i] == 'X' || digits[i] == 'x') {
+			d = 10
+		} else if digits[i] < '0' || digits[i] > '9' {
+			return errors.New("The value passed in was not a valid ISBN-10.")
+		}
+
+		sum += (10 - i) * d
+	}
+
+	if sum%11 != 0 {
+		return errors.New("The ISBN-10 passed in did not pass its check digit.")
+	}
+
+	return nil
+}
+
+// Check that a string is an ISBN-13 with a 978 or 979 prefix and a valid
+// check digit. Hyphens and spaces are ignored.
+func isbn13(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ISBN13 could not be converted to a string.")
+	}
+
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(data)
+
+	if len(digits) != 13 || !allDigits(digits) || (!strings.HasPrefix(digits, "978") && !strings.HasPrefix(digits, "979")) {
+		return errors.New("The value passed in was not a valid ISBN-13.")
+	}
+
+	sum := 0
+
+	for i := 0; i < 13; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += weight * int(digits[i]-'0')
+	}
+
+	if sum%10 != 0 {
+		return errors.New("The ISBN-13 passed in did not pass its check digit.")
+	}
+
+	return nil
+}
+
+// Check that a string is an active ISO 4217 currency code such as USD.
+func iso4217(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ISO4217 could not be converted to a string.")
+	}
+
+	if !currencies[data] {
+		return errors.New("The value passed in was not a valid currency code.")
+	}
+
+	return nil
+}
+
+// Check that a string or json.Number is a plain decimal number that fits in
+// the precision and scale passed in, the same way a SQL DECIMAL(p,s) column
+// does: at most s digits after the point and p-s before it.
+// For example decimal:10,2 allows 12345678.99 but not 1.999.
+func decimal(field string, value interface{}) error {
+
+	bounds := params(field)
+	if len(bounds) != 2 {
+		return errors.New("DECIMAL requires exactly two paramaters.")
+	}
+
+	precision, perr := strconv.Atoi(bounds[0])
+	scale, serr := strconv.Atoi(bounds[1])
+	if perr != nil || serr != nil || scale > precision || scale < 0 {
+		return errors.New("The value passed in for DECIMAL could not be converted to a precision and scale.")
+	}
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for DECIMAL could not be converted to a string.")
+	}
+
+	number := strings.TrimLeft(data, "+-")
+	if len(data)-len(number) > 1 {
+		return errors.New("The value passed in was not a decimal number.")
+	}
+
+	whole, fraction, _ := strings.Cut(number, ".")
+
+	if (whole == "" && fraction == "") || !allDigits(whole) || !allDigits(fraction) || strings.HasSuffix(number, ".") {
+		return errors.New("The value passed in was not a decimal number.")
+	}
+
+	whole = strings.TrimLeft(whole, "0")
+
+	if len(fraction) > scale {
+		return errors.New("The value passed in has more than " + strconv.Itoa(scale) + " digits after the decimal point.")
+	}
+
+	if len(whole) > precision-scale {
+		return errors.New("The value passed in has more than " + strconv.Itoa(precision-scale) + " digits before the decimal point.")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package val
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFinanceRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"luhn", "79927398713", true},
+		{"luhn", "79927398710", false},
+		{"luhn", "7992 7398 713", false},
+		{"credit_card", "4111111111111111", true},
+		{"credit_card", "4111 1111 1111 1111", true},
+		{"credit_card", "5500-0000-0000-0004", true},
+		{"credit_card", "4111111111111112", false},
+		{"credit_card", "41111", false},
+		{"iban", "GB82WEST12345698765432", true},
+		{"iban", "GB82 WEST 1234 5698 7654 32", true},
+		{"iban", "de89370400440532013000", true},
+		{"iban", "GB82WEST12345698765431", false},
+		{"iban", "GB82WEST1234569876543", false},
+		{"iban", "ZZ82WEST12345698765432", false},
+		{"iban", "GB82-WEST12345698765432", false},
+		{"bic", "DEUTDEFF", true},
+		{"bic", "DEUTDEFF500", true},
+		{"bic", "deutdeff", false},
+		{"bic", "DEUT1EFF", false},
+		{"bic", "DEUTDEF", false},
+		{"isbn10", "0306406152", true},
+		{"isbn10", "0-306-40615-2", true},
+		{"isbn10", "080442957X", true},
+		{"isbn10", "0306406153", false},
+		{"isbn10", "X306406152", false},
+		{"isbn13", "9780306406157", true},
+		{"isbn13", "978-0-306-40615-7", true},
+		{"isbn13", "9780306406158", false},
+		{"isbn13", "1230306406157", false},
+		{"iso4217", "USD", true},
+		{"iso4217", "EUR", true},
+		{"iso4217", "usd", false},
+		{"iso4217", "ABC", false},
+		{"decimal:10,2", "12345678.99", true},
+		{"decimal:10,2", "-0.5", true},
+		{"decimal:10,2", "007", true},
+		{"decimal:10,2", "1.999", false},
+		{"decimal:10,2", "123456789.1", false},
+		{"decimal:10,2", "1e5", false},
+		{"decimal:10,2", "1.", false},
+		{"decimal:10,2", ".5", true},
+		{"decimal:10,2", "--1", false},
+		{"decimal:10,2", "", false},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestDecimalNumber(t *testing.T) {
+
+	type testPayment struct {
+		Amount   json.Number `json:"amount" validate:"required|decimal:8,2"`
+		Currency *string     `json:"currency" validate:"required|iso4217"`
+	}
+
+	var test testPayment
+
+	if err := Bind(jsonFactory(`{"amount": 1999.99, "currency": "GBP"}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testPayment
+
+	if errs, ok := Bind(jsonFactory(`{"amount": 19.999, "currency": "XYZ"}`), &test2).(Errors); !ok || len(errs) != 2 {
+		t.Errorf("Both the amount and currency should fail but got %v.", errs)
+	}
+}
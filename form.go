@@ -0,0 +1,260 @@
+package val
+
+import (
+	"errors"
+	"io"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Memory used to hold multipart uploads before they are written to disk.
+const formMaxMemory = 32 << 20
+
+var (
+	fileHeaderType  = reflect.TypeOf((*multipart.FileHeader)(nil))
+	fileHeadersType = reflect.TypeOf([]*multipart.FileHeader(nil))
+)
+
+// BindForm fills obj from an application/x-www-form-urlencoded or
+// multipart/form-data request and then validates it. Keys are taken from the
+// form tag, falling back to the json tag and then the field name. Only the
+// body is used, values in the URL query are ignored. Uploads are bound to
+// fields of type *multipart.FileHeader or []*multipart.FileHeader.
+//
+//	var Avatar struct {
+//	    Name  *string               `form:"name" validate:"required"`
+//	    Image *multipart.FileHeader `form:"image" validate:"required|max_size:5MB|mime:image/png,image/jpeg"`
+//	}
+//
+//	err := val.BindForm(r, &Avatar)
+func BindForm(r *http.Request, obj interface{}) error {
+
+	value, err := settable(obj)
+	if err != nil {
+		return err
+	}
+
+	errs, err := decodeForm(r, value)
+	if err != nil {
+		return err
+	}
+
+	return merge(errs, validateStruct(value, "", formTags)).err()
+}
+
+// Keys used to find the form value for a field.
+var formTags = []string{"form", "json"}
+
+// Fill value from the form in the body of r without validating it.
+// Conversion failures are returned as Errors.
+func decodeForm(r *http.Request, value reflect.Value) (Errors, error) {
+
+	if err := parseForm(r); err != nil {
+		return nil, err
+	}
+
+	// Only use values from the body, r.Form also holds the URL query.
+	values := r.PostForm
+	if r.MultipartForm != nil {
+		values = r.MultipartForm.Value
+	}
+
+	errs := bindValues(value, "", formTags, func(key string) ([]string, bool) {
+		raw, ok := values[key]
+		return raw, ok
+	})
+
+	if r.MultipartForm != nil {
+		bindFiles(value, formTags, r.MultipartForm.File)
+	}
+
+	return errs, nil
+}
+
+// Parse the body of r as a multipart form when it says it is one and as a
+// url encoded form otherwise.
+func parseForm(r *http.Request) error {
+
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && mediaType == "multipart/form-data" {
+		return r.ParseMultipartForm(formMaxMemory)
+	}
+
+	return r.ParseForm()
+}
+
+// Set every file field of a struct that has a matching upload.
+func bindFiles(value reflect.Value, tags []string, files map[string][]*multipart.FileHeader) {
+
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, tagged := fieldName(field, tags)
+
+		if field.Anonymous && !tagged && field.Type.Kind() == reflect.Struct {
+			bindFiles(value.Field(i), tags, files)
+			continue
+		}
+
+		if _, ok := sourceName(field); ok || skipped(field, tags) {
+			continue
+		}
+
+		uploads := files[name]
+		if len(uploads) == 0 {
+			continue
+		}
+
+		switch field.Type {
+		case fileHeaderType:
+			value.Field(i).Set(reflect.ValueOf(uploads[0]))
+		case fileHeadersType:
+			value.Field(i).Set(reflect.ValueOf(uploads))
+		}
+	}
+}
+
+// Return the uploads held by a *multipart.FileHeader or
+// []*multipart.FileHeader field.
+func uploads(value interface{}) ([]*multipart.FileHeader, bool) {
+
+	switch data := value.(type) {
+	case *multipart.FileHeader:
+		return []*multipart.FileHeader{data}, true
+	case *[]*multipart.FileHeader:
+		return *data, true
+	}
+
+	return nil, false
+}
+
+// Check that every uploaded file is no larger than the size passed in.
+// Sizes may be given in bytes or with a B, KB, MB or GB suffix.
+func max_size(field string, value interface{}) error {
+
+	limit, err := parseSize(field[strings.Index(field, ":")+1:])
+	if err != nil {
+		return err
+	}
+
+	files, ok := uploads(value)
+	if !ok {
+		return errors.New("The value passed in for MAX SIZE was not an uploaded file.")
+	}
+
+	for _, file := range files {
+		if file.Size > limit {
+			return errors.New("The file " + file.Filename + " is larger than the maximum size.")
+		}
+	}
+
+	return nil
+}
+
+// Turn a size such as 512KB or 5MB in to bytes. Units are powers of 1024.
+func parseSize(size string) (int64, error) {
+
+	units := []struct {
+		suffix string
+		scale  int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	}
+
+	scale := int64(1)
+	number := strings.ToUpper(strings.TrimSpace(size))
+
+	for _, unit := range units {
+		if strings.HasSuffix(number, unit.suffix) {
+			number = strings.TrimSpace(strings.TrimSuffix(number, unit.suffix))
+			scale = unit.scale
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || n < 0 || n > math.MaxInt64/scale {
+		return 0, errors.New("The value passed in for MAX SIZE could not be converted to a size.")
+	}
+
+	return n * scale, nil
+}
+
+// Check that the content of every uploaded file is one of the listed media
+// types. The type is sniffed from the file itself rather than trusting the
+// filename or the type sent by the client. A type of image/* allows any image.
+func mime_type(field string, value interface{}) error {
+
+	allowed := strings.Split(field[strings.Index(field, ":")+1:], ",")
+
+	files, ok := uploads(value)
+	if !ok {
+		return errors.New("The value passed in for MIME was not an uploaded file.")
+	}
+
+	for _, file := range files {
+		detected, err := sniff(file)
+		if err != nil {
+			return err
+		}
+
+		if !matchMediaType(detected, allowed) {
+			return errors.New("The file " + file.Filename + " is of type " + detected + " which is not allowed.")
+		}
+	}
+
+	return nil
+}
+
+// Detect the media type of an upload from its first 512 bytes.
+func sniff(file *multipart.FileHeader) (string, error) {
+
+	f, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// Read may return less than it could, ReadFull keeps going until the
+	// buffer is full or the file ends.
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+
+	return detected, nil
+}
+
+func matchMediaType(detected string, allowed []string) bool {
+
+	for _, option := range allowed {
+		option = strings.TrimSpace(option)
+
+		if option == detected {
+			return true
+		}
+
+		if strings.HasSuffix(option, "/*") && strings.HasPrefix(detected, option[:len(option)-1]) {
+			return true
+		}
+	}
+
+	return false
+}
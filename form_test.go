@@ -0,0 +1,170 @@
+package val
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Build a multipart request with the passed in fields and a single file.
+func multipartFactory(fields map[string]string, file, filename string, content []byte) *http.Request {
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		writer.WriteField(key, value)
+	}
+
+	if file != "" {
+		part, _ := writer.CreateFormFile(file, filename)
+		part.Write(content)
+	}
+
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", "/", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return req
+}
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestBindForm(t *testing.T) {
+
+	var testForm struct {
+		Name  *string  `form:"name" validate:"required"`
+		Age   int      `json:"age" validate:"min:18"`
+		Roles []string `form:"role"`
+	}
+
+	req, _ := http.NewRequest("POST", "/", strings.NewReader("name=michael&age=30&role=admin&role=user"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if err := BindForm(req, &testForm); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testForm.Name != "michael" || testForm.Age != 30 || len(testForm.Roles) != 2 {
+		t.Error("Form values were not bound to the struct.")
+	}
+
+	var testForm2 struct {
+		Name *string `form:"name" validate:"required"`
+		Age  int     `form:"age" validate:"min:18"`
+	}
+
+	req, _ = http.NewRequest("POST", "/", strings.NewReader("age=12"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if errs, ok := BindForm(req, &testForm2).(Errors); !ok || len(errs) != 2 {
+		t.Errorf("Missing name and an age under 18 should return two errors but got %v.", errs)
+	}
+
+	// Values in the URL query are not part of the form body.
+	var testForm3 struct {
+		Name *string `form:"name" validate:"required"`
+		Age  int     `form:"age"`
+	}
+
+	req, _ = http.NewRequest("POST", "/?name=query&age=40", strings.NewReader("age=30"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if errs, ok := BindForm(req, &testForm3).(Errors); !ok || len(errs) != 1 || errs[0].Field != "name" || testForm3.Age != 30 {
+		t.Errorf("Only the body should be bound but got %v and age %d.", errs, testForm3.Age)
+	}
+
+	var testForm4 struct {
+		Name *string `form:"name" validate:"required"`
+	}
+
+	req = multipartFactory(nil, "", "", nil)
+	req.URL.RawQuery = "name=query"
+
+	if err := BindForm(req, &testForm4); err == nil || testForm4.Name != nil {
+		t.Error("A multipart form should not be filled from the URL query.")
+	}
+}
+
+func TestBindFormFiles(t *testing.T) {
+
+	type testUpload struct {
+		Name  *string               `form:"name" validate:"required"`
+		Image *multipart.FileHeader `form:"image" validate:"required|max_size:1KB|mime:image/png,image/jpeg"`
+	}
+
+	var testFile testUpload
+	req := multipartFactory(map[string]string{"name": "avatar"}, "image", "me.png", pngHeader)
+
+	if err := BindForm(req, &testFile); err != nil {
+		t.Fatal(err)
+	}
+
+	if testFile.Image == nil || testFile.Image.Filename != "me.png" || *testFile.Name != "avatar" {
+		t.Error("The uploaded file and name were not bound to the struct.")
+	}
+
+	// The filename says png but the content is text.
+	var testFile2 testUpload
+	req = multipartFactory(map[string]string{"name": "avatar"}, "image", "me.png", []byte("just some text"))
+
+	if err := BindForm(req, &testFile2); err == nil {
+		t.Error("A text file named .png should not pass the mime rule.")
+	}
+
+	var testFile3 testUpload
+	req = multipartFactory(map[string]string{"name": "avatar"}, "image", "me.png", append(pngHeader, make([]byte, 2048)...))
+
+	if err := BindForm(req, &testFile3); err == nil {
+		t.Error("A 2KB file should not pass max_size:1KB.")
+	}
+
+	var testFile4 testUpload
+	req = multipartFactory(map[string]string{"name": "avatar"}, "", "", nil)
+
+	if err := BindForm(req, &testFile4); err == nil {
+		t.Error("A required file that was not uploaded should return an error.")
+	}
+
+	var testFiles struct {
+		Images []*multipart.FileHeader `form:"image" validate:"mime:image/*"`
+	}
+	req = multipartFactory(nil, "image", "me.png", pngHeader)
+
+	if err := BindForm(req, &testFiles); err != nil || len(testFiles.Images) != 1 {
+		t.Errorf("Uploads should bind to a slice of files and match image/* but got %v.", err)
+	}
+}
+
+func TestParseSize(t *testing.T) {
+
+	sizes := map[string]int64{
+		"100":   100,
+		"100B":  100,
+		"2KB":   2048,
+		"5MB":   5 << 20,
+		"1gb":   1 << 30,
+		"10 MB": 10 << 20,
+	}
+
+	for size, expected := range sizes {
+		if n, err := parseSize(size); err != nil || n != expected {
+			t.Errorf("parseSize(%q) returned %d, %v but expected %d.", size, n, err, expected)
+		}
+	}
+
+	if _, err := parseSize("five"); err == nil {
+		t.Error("parseSize should not accept five.")
+	}
+
+	if _, err := parseSize("9223372036854775807GB"); err == nil {
+		t.Error("parseSize should reject sizes that overflow an int64.")
+	}
+
+	if n, err := parseSize("8589934591GB"); err != nil || n <= 0 {
+		t.Errorf("The largest size that fits should be accepted but got %d, %v.", n, err)
+	}
+}
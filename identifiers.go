@@ -0,0 +1,288 @@
+package val
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Check that a string is a UUID in its canonical 8-4-4-4-12 form. A version
+// can be passed, e.g. uuid:4, which also requires the RFC 4122 variant.
+func uuid(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for UUID could not be converted to a string.")
+	}
+
+	if len(data) != 36 {
+		return errors.New("The value passed in was not a valid UUID.")
+	}
+
+	for i := 0; i < len(data); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if data[i] != '-' {
+				return errors.New("The value passed in was not a valid UUID.")
+			}
+		default:
+			if !isHex(data[i]) {
+				return errors.New("The value passed in was not a valid UUID.")
+			}
+		}
+	}
+
+	if i := strings.Index(field, ":"); i >= 0 {
+		version := field[i+1:]
+
+		if len(version) != 1 || data[14] != version[0] || !strings.ContainsRune("89abAB", rune(data[19])) {
+			return errors.New("The value passed in was not a version " + version + " UUID.")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is a ULID, 26 characters of Crockford's base32.
+func ulid(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ULID could not be converted to a string.")
+	}
+
+	// The first character only holds 3 bits so anything over 7 overflows.
+	if len(data) != 26 || data[0] > '7' {
+		return errors.New("The value passed in was not a valid ULID.")
+	}
+
+	for i := 0; i < len(data); i++ {
+		if !strings.ContainsRune("0123456789ABCDEFGHJKMNPQRSTVWXYZabcdefghjkmnpqrstvwxyz", rune(data[i])) {
+			return errors.New("The value passed in was not a valid ULID.")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is a semantic version as described by semver 2.0.0,
+// e.g. 1.2.3, 1.0.0-rc.1 or 1.0.0+build.5. A leading v is not allowed.
+func semver(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for SEMVER could not be converted to a string.")
+	}
+
+	version, build, hasBuild := strings.Cut(data, "+")
+	version, prerelease, hasPrerelease := strings.Cut(version, "-")
+
+	core := strings.Split(version, ".")
+	valid := len(core) == 3
+
+	for _, part := range core {
+		valid = valid && numericIdentifier(part)
+	}
+
+	if hasPrerelease {
+		for _, part := range strings.Split(prerelease, ".") {
+			valid = valid && identifier(part) && (!allDigits(part) || numericIdentifier(part))
+		}
+	}
+
+	if hasBuild {
+		for _, part := range strings.Split(build, ".") {
+			valid = valid && identifier(part)
+		}
+	}
+
+	if !valid {
+		return errors.New("The value passed in was not a valid semantic version.")
+	}
+
+	return nil
+}
+
+// Check that a string is a slug, lower case letters and digits in groups
+// separated by single hyphens such as my-first-post.
+func slug(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for SLUG could not be converted to a string.")
+	}
+
+	for _, part := range strings.Split(data, "-") {
+		if part == "" {
+			return errors.New("The value passed in was not a valid slug.")
+		}
+
+		for i := 0; i < len(part); i++ {
+			if !('a' <= part[i] && part[i] <= 'z' || '0' <= part[i] && part[i] <= '9') {
+				return errors.New("The value passed in was not a valid slug.")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is made only of hexadecimal digits.
+func hexadecimal(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for HEXADECIMAL could not be converted to a string.")
+	}
+
+	if data == "" {
+		return errors.New("The value passed in was not hexadecimal.")
+	}
+
+	for i := 0; i < len(data); i++ {
+		if !isHex(data[i]) {
+			return errors.New("The value passed in was not hexadecimal.")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is a CSS style hex color, #rgb, #rgba, #rrggbb or #rrggbbaa.
+func hexcolor(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for HEXCOLOR could not be converted to a string.")
+	}
+
+	if len(data) == 0 || data[0] != '#' {
+		return errors.New("The value passed in was not a hex color.")
+	}
+
+	switch len(data) - 1 {
+	case 3, 4, 6, 8:
+	default:
+		return errors.New("The value passed in was not a hex color.")
+	}
+
+	for i := 1; i < len(data); i++ {
+		if !isHex(data[i]) {
+			return errors.New("The value passed in was not a hex color.")
+		}
+	}
+
+	return nil
+}
+
+// Check that a string is padded standard base64.
+func base64_rule(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for BASE64 could not be converted to a string.")
+	}
+
+	if _, err := base64.StdEncoding.Strict().DecodeString(data); err != nil {
+		return errors.New("The value passed in was not valid base64.")
+	}
+
+	return nil
+}
+
+// Check that a string is URL safe base64, with or without padding.
+func base64url(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for BASE64URL could not be converted to a string.")
+	}
+
+	encoding := base64.RawURLEncoding
+	if strings.HasSuffix(data, "=") {
+		encoding = base64.URLEncoding
+	}
+
+	if _, err := encoding.Strict().DecodeString(data); err != nil {
+		return errors.New("The value passed in was not valid URL safe base64.")
+	}
+
+	return nil
+}
+
+// Check that a string is shaped like a JSON Web Token: a header and payload
+// that are base64url encoded JSON objects and a base64url signature. The
+// signature is not verified.
+func jwt(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for JWT could not be converted to a string.")
+	}
+
+	parts := strings.Split(data, ".")
+	if len(parts) != 3 {
+		return errors.New("The value passed in was not a JWT.")
+	}
+
+	for _, part := range parts[:2] {
+		b, err := base64.RawURLEncoding.Strict().DecodeString(part)
+
+		var object map[string]interface{}
+		if err != nil || json.Unmarshal(b, &object) != nil || object == nil {
+			return errors.New("The value passed in was not a JWT.")
+		}
+	}
+
+	if _, err := base64.RawURLEncoding.Strict().DecodeString(parts[2]); err != nil {
+		return errors.New("The value passed in was not a JWT.")
+	}
+
+	return nil
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func allDigits(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A semver number, digits without a leading zero.
+func numericIdentifier(s string) bool {
+
+	if !allDigits(s) || s == "" || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+
+	_, err := strconv.ParseUint(s, 10, 64)
+
+	return err == nil
+}
+
+// A semver identifier, letters, digits and hyphens.
+func identifier(s string) bool {
+
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
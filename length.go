@@ -0,0 +1,156 @@
+package val
+
+import (
+	"errors"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Check the length of a string in bytes, either exactly with length_bytes:5
+// or within bounds with length_bytes:3,10.
+func length_bytes(field string, value interface{}) error {
+	return measure(field, value, "LENGTH BYTES", func(s string) int { return len(s) })
+}
+
+// Check the length of a string in user perceived characters, so an accented
+// letter made of two runes or a flag emoji counts once. Takes an exact length
+// or bounds like length_bytes.
+func length_graphemes(field string, value interface{}) error {
+	return measure(field, value, "LENGTH GRAPHEMES", graphemes)
+}
+
+// Check that a string has at least the number of characters passed in.
+func min_length(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for MIN LENGTH could not be converted to a string.")
+	}
+
+	bound, err := strconv.Atoi(field[len("min_length:"):])
+	if err != nil {
+		return errors.New("The value passed in for MIN LENGTH could not be converted to an int.")
+	}
+
+	if utf8.RuneCountInString(data) < bound {
+		return errors.New("The data passed in was shorter than " + strconv.Itoa(bound) + " characters.")
+	}
+
+	return nil
+}
+
+// Check that a string has at most the number of characters passed in.
+func max_length(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for MAX LENGTH could not be converted to a string.")
+	}
+
+	bound, err := strconv.Atoi(field[len("max_length:"):])
+	if err != nil {
+		return errors.New("The value passed in for MAX LENGTH could not be converted to an int.")
+	}
+
+	if utf8.RuneCountInString(data) > bound {
+		return errors.New("The data passed in was longer than " + strconv.Itoa(bound) + " characters.")
+	}
+
+	return nil
+}
+
+// Compare the size of a string, as counted by count, against one exact
+// length or a lower and upper bound.
+func measure(field string, value interface{}, name string, count func(string) int) error {
+
+	bounds := params(field)
+	if len(bounds) > 2 {
+		return errors.New(name + " requires one or two paramaters.")
+	}
+
+	low, lowErr := strconv.Atoi(bounds[0])
+	high, highErr := low, error(nil)
+	if len(bounds) == 2 {
+		high, highErr = strconv.Atoi(bounds[1])
+	}
+
+	if lowErr != nil || highErr != nil {
+		return errors.New("The value passed in for " + name + " could not be converted to an int.")
+	}
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for " + name + " could not be converted to a string.")
+	}
+
+	if size := count(data); size < low || size > high {
+		if low == high {
+			return errors.New("The data passed in was not equal to the expected length.")
+		}
+		return errors.New("The value passed in for " + name + " was not in bounds.")
+	}
+
+	return nil
+}
+
+// Count the grapheme clusters in a string. This follows the parts of
+// Unicode's segmentation rules (UAX #29) that matter for user input:
+// combining marks, variation selectors, emoji modifiers and ZWJ sequences
+// join the character before them, regional indicators pair up in to flags
+// and CR LF counts once.
+func graphemes(s string) int {
+
+	count := 0
+	joined := false
+	flag := false
+	var previous rune
+
+	for _, r := range s {
+		switch {
+		case joined:
+			joined = false
+		case r == '\u200d':
+			joined = count > 0
+		case extends(r) && count > 0:
+		case r == '\n' && previous == '\r':
+		case 0x1f1e6 <= r && r <= 0x1f1ff:
+			if !flag {
+				count++
+			}
+			flag = !flag
+		default:
+			count++
+		}
+
+		if r < 0x1f1e6 || r > 0x1f1ff {
+			flag = false
+		}
+		previous = r
+	}
+
+	return count
+}
+
+// Runes that extend the grapheme cluster before them.
+func extends(r rune) bool {
+
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case 0xfe00 <= r && r <= 0xfe0f, 0xe0100 <= r && r <= 0xe01ef:
+		// Variation selectors.
+		return true
+	case 0x1f3fb <= r && r <= 0x1f3ff:
+		// Emoji skin tone modifiers.
+		return true
+	case 0xe0020 <= r && r <= 0xe007f:
+		// Tags used by subdivision flags.
+		return true
+	case 0x1160 <= r && r <= 0x11ff:
+		// Hangul vowel and trailing jamo.
+		return true
+	}
+
+	return false
+}
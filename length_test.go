@@ -0,0 +1,61 @@
+package val
+
+import (
+	"testing"
+)
+
+func TestLengthRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"length:5", "こんにちは", true},
+		{"length:5", "hello", true},
+		{"length:5", "héllo", true},
+		{"length:15", "こんにちは", false},
+		{"length_between:2,5", "日本語", true},
+		{"length_between:4,5", "日本語", false},
+		{"length_bytes:15", "こんにちは", true},
+		{"length_bytes:5", "こんにちは", false},
+		{"length_bytes:1,6", "héllo", true},
+		{"length_bytes:1,5", "héllo", false},
+		{"length_graphemes:5", "he\u0301llo", true},
+		{"length:5", "he\u0301llo", false},
+		{"length_graphemes:1", "🇬🇧", true},
+		{"length_graphemes:2", "🇬🇧🇫🇷", true},
+		{"length_graphemes:1", "👩‍💻", true},
+		{"length_graphemes:1", "👍🏽", true},
+		{"length_graphemes:1", "\r\n", true},
+		{"length_graphemes:2,3", "ok", true},
+		{"length_graphemes:3,4", "ok", false},
+		{"length_graphemes:1,2,3", "ok", false},
+		{"length_graphemes:a", "ok", false},
+		{"min_length:3", "日本語", true},
+		{"min_length:4", "日本語", false},
+		{"max_length:3", "日本語", true},
+		{"max_length:2", "日本語", false},
+		{"max_length:two", "日本語", false},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestLengthNamedString(t *testing.T) {
+
+	type username string
+
+	value := username("ユーザー")
+
+	if err := check(testField, "length:4", &value, value, testParent); err != nil {
+		t.Error(err)
+	}
+}
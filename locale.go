@@ -0,0 +1,324 @@
+package val
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISO 3166-1 countries as alpha-2 and alpha-3 pairs.
+const countryCodes = `
+	AD AND AE ARE AF AFG AG ATG AI AIA AL ALB AM ARM AO AGO AQ ATA AR ARG AS ASM
+	AT AUT AU AUS AW ABW AX ALA AZ AZE BA BIH BB BRB BD BGD BE BEL BF BFA BG BGR
+	BH BHR BI BDI BJ BEN BL BLM BM BMU BN BRN BO BOL BQ BES BR BRA BS BHS BT BTN
+	BV BVT BW BWA BY BLR BZ BLZ CA CAN CC CCK CD COD CF CAF CG COG CH CHE CI CIV
+	CK COK CL CHL CM CMR CN CHN CO COL CR CRI CU CUB CV CPV CW CUW CX CXR CY CYP
+	CZ CZE DE DEU DJ DJI DK DNK DM DMA DO DOM DZ DZA EC ECU EE EST EG EGY EH ESH
+	ER ERI ES ESP ET ETH FI FIN FJ FJI FK FLK FM FSM FO FRO FR FRA GA GAB GB GBR
+	GD GRD GE GEO GF GUF GG GGY GH GHA GI GIB GL GRL GM GMB GN GIN GP GLP GQ GNQ
+	GR GRC GS SGS GT GTM GU GUM GW GNB GY GUY HK HKG HM HMD HN HND HR HRV HT HTI
+	HU HUN ID IDN IE IRL IL ISR IM IMN IN IND IO IOT IQ IRQ IR IRN IS ISL IT ITA
+	JE JEY JM JAM JO JOR JP JPN KE KEN KG KGZ KH KHM KI KIR KM COM KN KNA KP PRK
+	KR KOR KW KWT KY CYM KZ KAZ LA LAO LB LBN LC LCA LI LIE LK LKA LR LBR LS LSO
+	LT LTU LU LUX LV LVA LY LBY MA MAR MC MCO MD MDA ME MNE MF MAF MG MDG MH MHL
+	MK MKD ML MLI MM MMR MN MNG MO MAC MP MNP MQ MTQ MR MRT MS MSR MT MLT MU MUS
+	MV MDV MW MWI MX MEX MY MYS MZ MOZ NA NAM NC NCL NE NER NF NFK NG NGA NI NIC
+	NL NLD NO NOR NP NPL NR NRU NU NIU NZ NZL OM OMN PA PAN PE PER PF PYF PG PNG
+	PH PHL PK PAK PL POL PM SPM PN PCN PR PRI PS PSE PT PRT PW PLW PY PRY QA QAT
+	RE REU RO ROU RS SRB RU RUS RW RWA SA SAU SB SLB SC SYC SD SDN SE SWE SG SGP
+	SH SHN SI SVN SJ SJM SK SVK SL SLE SM SMR SN SEN SO SOM SR SUR SS SSD ST STP
+	SV SLV SX SXM SY SYR SZ SWZ TC TCA TD TCD TF ATF TG TGO TH THA TJ TJK TK TKL
+	TL TLS TM TKM TN TUN TO TON TR TUR TT TTO TV TUV TW TWN TZ TZA UA UKR UG UGA
+	UM UMI US USA UY URY UZ UZB VA VAT VC VCT VE VEN VG VGB VI VIR VN VNM VU VUT
+	WF WLF WS WSM YE YEM YT MYT ZA ZAF ZM ZMB ZW ZWE
+`
+
+var alpha2Countries, alpha3Countries = countrySets(countryCodes)
+
+// ISO 639-1 two letter language codes, used for the primary subtag of a
+// BCP 47 language tag.
+var languages = codeSet(`
+	aa ab ae af ak am an ar as av ay az ba be bg bh bi bm bn bo br bs ca ce ch co
+	cr cs cu cv cy da de dv dz ee el en eo es et eu fa ff fi fj fo fr fy ga gd gl
+	gn gu gv ha he hi ho hr ht hu hy hz ia id ie ig ii ik io is it iu ja jv ka kg
+	ki kj kk kl km kn ko kr ks ku kv kw ky la lb lg li ln lo lt lu lv mg mh mi mk
+	ml mn mr ms mt my na nb nd ne ng nl nn no nr nv ny oc oj om or os pa pi pl ps
+	pt qu rm rn ro ru rw sa sc sd se sg si sk sl sm sn so sq sr ss st su sv sw ta
+	te tg th ti tk tl tn to tr ts tt tw ty ug uk ur uz ve vi vo wa wo xh yi yo za
+	zh zu
+`)
+
+// Split the alpha-2 and alpha-3 pairs in to a set for each.
+func countrySets(pairs string) (map[string]bool, map[string]bool) {
+
+	alpha2 := make(map[string]bool)
+	alpha3 := make(map[string]bool)
+
+	codes := strings.Fields(pairs)
+
+	for i := 0; i+1 < len(codes); i += 2 {
+		alpha2[codes[i]] = true
+		alpha3[codes[i+1]] = true
+	}
+
+	return alpha2, alpha3
+}
+
+// Check that a string is an upper case ISO 3166-1 alpha-2 country code.
+func iso3166_alpha2(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ISO3166 ALPHA2 could not be converted to a string.")
+	}
+
+	if !alpha2Countries[data] {
+		return errors.New("The value passed in was not a valid country code.")
+	}
+
+	return nil
+}
+
+// Check that a string is an upper case ISO 3166-1 alpha-3 country code.
+func iso3166_alpha3(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for ISO3166 ALPHA3 could not be converted to a string.")
+	}
+
+	if !alpha3Countries[data] {
+		return errors.New("The value passed in was not a valid country code.")
+	}
+
+	return nil
+}
+
+// Check that a string is a well formed BCP 47 language tag such as en,
+// en-GB, zh-Hant-TW or es-419. Two letter languages must be ISO 639-1 codes.
+func bcp47(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for BCP47 could not be converted to a string.")
+	}
+
+	if !languageTag(strings.Split(strings.ToLower(data), "-")) {
+		return errors.New("The value passed in was not a valid language tag.")
+	}
+
+	return nil
+}
+
+// Walk the subtags of a language tag in the order RFC 5646 gives them:
+// language, extlang, script, region, variants, extensions and private use.
+func languageTag(tags []string) bool {
+
+	if tags[0] == "x" {
+		return privateUse(tags[1:])
+	}
+
+	i := 0
+	lang := tags[0]
+
+	switch {
+	case len(lang) == 2 && lowerAlpha(lang):
+		if !languages[lang] {
+			return false
+		}
+	case len(lang) >= 3 && len(lang) <= 8 && lowerAlpha(lang):
+	default:
+		return false
+	}
+	i++
+
+	// Up to three extended language subtags follow a short language.
+	for n := 0; n < 3 && len(lang) <= 3 && i < len(tags) && len(tags[i]) == 3 && lowerAlpha(tags[i]); n++ {
+		i++
+	}
+
+	if i < len(tags) && len(tags[i]) == 4 && lowerAlpha(tags[i]) {
+		i++
+	}
+
+	if i < len(tags) && ((len(tags[i]) == 2 && lowerAlpha(tags[i])) || (len(tags[i]) == 3 && allDigits(tags[i]))) {
+		i++
+	}
+
+	seen := make(map[string]bool)
+
+	for ; i < len(tags) && variant(tags[i]); i++ {
+		if seen[tags[i]] {
+			return false
+		}
+		seen[tags[i]] = true
+	}
+
+	for i < len(tags) && len(tags[i]) == 1 && tags[i] != "x" && lowerAlphanumeric(tags[i]) {
+		if seen[tags[i]] {
+			return false
+		}
+		seen[tags[i]] = true
+		i++
+
+		start := i
+		for i < len(tags) && len(tags[i]) >= 2 && len(tags[i]) <= 8 && lowerAlphanumeric(tags[i]) {
+			i++
+		}
+
+		if i == start {
+			return false
+		}
+	}
+
+	if i < len(tags) && tags[i] == "x" {
+		return privateUse(tags[i+1:])
+	}
+
+	return i == len(tags)
+}
+
+// A variant is 5 to 8 letters or digits, or 4 starting with a digit.
+func variant(tag string) bool {
+
+	if !lowerAlphanumeric(tag) {
+		return false
+	}
+
+	return (len(tag) >= 5 && len(tag) <= 8) || (len(tag) == 4 && tag[0] >= '0' && tag[0] <= '9')
+}
+
+// Private use subtags follow an x and are 1 to 8 letters or digits.
+func privateUse(tags []string) bool {
+
+	if len(tags) == 0 {
+		return false
+	}
+
+	for _, tag := range tags {
+		if len(tag) < 1 || len(tag) > 8 || !lowerAlphanumeric(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func lowerAlpha(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func lowerAlphanumeric(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// Check that a string is an IANA time zone name such as Europe/London that
+// time.LoadLocation can resolve. Names are checked against the table in
+// zones.go so no zone database is needed, anything newer than the table is
+// looked up with time.LoadLocation. Local is rejected as it depends on the host.
+func timezone(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for TIMEZONE could not be converted to a string.")
+	}
+
+	if data == "" || data == "Local" {
+		return errors.New("The value passed in was not a valid time zone.")
+	}
+
+	if zones[data] {
+		return nil
+	}
+
+	if _, err := time.LoadLocation(data); err != nil {
+		return errors.New("The value passed in was not a valid time zone.")
+	}
+
+	return nil
+}
+
+// Check that a number or numeric string is a latitude between -90 and 90.
+func latitude(field string, value interface{}) error {
+
+	n, ok := floatValue(value)
+	if !ok {
+		return errors.New("The value passed in for LATITUDE could not be converted to a number.")
+	}
+
+	if math.IsNaN(n) || n < -90 || n > 90 {
+		return errors.New("The value passed in was not a valid latitude.")
+	}
+
+	return nil
+}
+
+// Check that a number or numeric string is a longitude between -180 and 180.
+func longitude(field string, value interface{}) error {
+
+	n, ok := floatValue(value)
+	if !ok {
+		return errors.New("The value passed in for LONGITUDE could not be converted to a number.")
+	}
+
+	if math.IsNaN(n) || n < -180 || n > 180 {
+		return errors.New("The value passed in was not a valid longitude.")
+	}
+
+	return nil
+}
+
+// Read any number, or a string holding one such as a json.Number, as a float.
+func floatValue(value interface{}) (float64, bool) {
+
+	data := indirectValue(value)
+
+	switch data.Kind() {
+	case reflect.String:
+		n, err := strconv.ParseFloat(data.String(), 64)
+		return n, err == nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(data.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(data.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return data.Float(), true
+	}
+
+	return 0, false
+}
+
+// Check that a string is an E.164 phone number, a + followed by 8 to 15
+// digits with no leading zero.
+func e164(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for E164 could not be converted to a string.")
+	}
+
+	digits := strings.TrimPrefix(data, "+")
+
+	if len(digits) == len(data) || len(digits) < 8 || len(digits) > 15 || !allDigits(digits) || digits[0] == '0' {
+		return errors.New("The value passed in was not a valid E.164 phone number.")
+	}
+
+	return nil
+}
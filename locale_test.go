@@ -0,0 +1,110 @@
+package val
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestLocaleRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"iso3166_alpha2", "GB", true},
+		{"iso3166_alpha2", "US", true},
+		{"iso3166_alpha2", "gb", false},
+		{"iso3166_alpha2", "UK", false},
+		{"iso3166_alpha2", "GBR", false},
+		{"iso3166_alpha3", "GBR", true},
+		{"iso3166_alpha3", "DEU", true},
+		{"iso3166_alpha3", "GB", false},
+		{"iso3166_alpha3", "XXX", false},
+		{"bcp47", "en", true},
+		{"bcp47", "en-GB", true},
+		{"bcp47", "zh-Hant-TW", true},
+		{"bcp47", "es-419", true},
+		{"bcp47", "sl-rozaj-biske", true},
+		{"bcp47", "de-CH-1901", true},
+		{"bcp47", "zh-yue-HK", true},
+		{"bcp47", "en-a-bbb-x-a-ccc", true},
+		{"bcp47", "x-whatever", true},
+		{"bcp47", "haw", true},
+		{"bcp47", "qq", false},
+		{"bcp47", "en-", false},
+		{"bcp47", "en_GB", false},
+		{"bcp47", "de-419-DE", false},
+		{"bcp47", "sl-rozaj-rozaj", false},
+		{"bcp47", "en-a", false},
+		{"bcp47", "en-a-bbb-a-ccc", false},
+		{"bcp47", "x", false},
+		{"bcp47", "", false},
+		{"timezone", "Europe/London", true},
+		{"timezone", "America/New_York", true},
+		{"timezone", "UTC", true},
+		{"timezone", "Local", false},
+		{"timezone", "Mars/Olympus_Mons", false},
+		{"timezone", "", false},
+		{"latitude", "51.5072", true},
+		{"latitude", "-90", true},
+		{"latitude", "90.1", false},
+		{"latitude", "north", false},
+		{"longitude", "-0.1276", true},
+		{"longitude", "180", true},
+		{"longitude", "-180.5", false},
+		{"longitude", "NaN", false},
+		{"e164", "+442071838750", true},
+		{"e164", "+14155552671", true},
+		{"e164", "442071838750", false},
+		{"e164", "+0442071838750", false},
+		{"e164", "+44 20 7183 8750", false},
+		{"e164", "+1234567", false},
+		{"e164", "+1234567890123456", false},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestCoordinates(t *testing.T) {
+
+	type testPlace struct {
+		Lat  float64     `json:"lat" validate:"latitude"`
+		Lng  json.Number `json:"lng" validate:"longitude"`
+		Zoom *int        `json:"zoom" validate:"latitude"`
+	}
+
+	var test testPlace
+
+	if err := Bind(jsonFactory(`{"lat": 51.5, "lng": -0.12, "zoom": 12}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testPlace
+
+	if errs, ok := Bind(jsonFactory(`{"lat": 91, "lng": 181, "zoom": 120}`), &test2).(Errors); !ok || len(errs) != 3 {
+		t.Errorf("Every coordinate should fail but got %v.", errs)
+	}
+}
+
+// Every name in the built in table should be one time.LoadLocation knows.
+func TestZoneTable(t *testing.T) {
+
+	if len(zones) < 400 || !zones["Europe/London"] || zones["Local"] {
+		t.Fatalf("The zone table looks incomplete, it has %d names.", len(zones))
+	}
+
+	for zone := range zones {
+		if _, err := time.LoadLocation(zone); err != nil {
+			t.Errorf("%s is in the zone table but could not be loaded: %v.", zone, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package val
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	addrType     = reflect.TypeOf(netip.Addr{})
+	prefixType   = reflect.TypeOf(netip.Prefix{})
+	addrPortType = reflect.TypeOf(netip.AddrPort{})
+)
+
+// Check that a string or netip.Addr is an IP address. Version is 4 or 6 to
+// only allow one kind, or 0 for either.
+func ip(field string, value interface{}, version int) error {
+
+	data := indirectValue(value)
+
+	var addr netip.Addr
+
+	switch {
+	case data.IsValid() && data.Type() == addrType:
+		addr = data.Interface().(netip.Addr)
+	case data.Kind() == reflect.String:
+		addr, _ = netip.ParseAddr(data.String())
+	default:
+		return errors.New("The value passed in for " + strings.ToUpper(field) + " could not be converted to a string.")
+	}
+
+	switch {
+	case !addr.IsValid():
+		return errors.New("The value passed in was not a valid IP address.")
+	case version == 4 && !addr.Is4():
+		return errors.New("The value passed in was not a valid IPv4 address.")
+	case version == 6 && !addr.Is6():
+		return errors.New("The value passed in was not a valid IPv6 address.")
+	}
+
+	return nil
+}
+
+// Check that a string or netip.Prefix is a network in CIDR notation.
+func cidr(field string, value interface{}) error {
+
+	data := indirectValue(value)
+
+	switch {
+	case data.IsValid() && data.Type() == prefixType:
+		if data.Interface().(netip.Prefix).IsValid() {
+			return nil
+		}
+	case data.Kind() == reflect.String:
+		if _, err := netip.ParsePrefix(data.String()); err == nil {
+			return nil
+		}
+	default:
+		return errors.New("The value passed in for CIDR could not be converted to a string.")
+	}
+
+	return errors.New("The value passed in was not valid CIDR notation.")
+}
+
+// Check that a string is a MAC address.
+func mac(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for MAC could not be converted to a string.")
+	}
+
+	if _, err := net.ParseMAC(data); err != nil {
+		return errors.New("The value passed in was not a valid MAC address.")
+	}
+
+	return nil
+}
+
+// Check that a string is a host name as described by RFC 1123.
+func hostname(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for HOSTNAME could not be converted to a string.")
+	}
+
+	if !validHostname(data) {
+		return errors.New("The value passed in was not a valid host name.")
+	}
+
+	return nil
+}
+
+// Check that a string is a fully qualified domain name. It must have at least
+// two labels and a top level domain that is not all numbers, a trailing dot
+// is allowed.
+func fqdn(field string, value interface{}) error {
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for FQDN could not be converted to a string.")
+	}
+
+	name := strings.TrimSuffix(data, ".")
+	labels := strings.Split(name, ".")
+	tld := labels[len(labels)-1]
+
+	if len(labels) < 2 || !validHostname(name) || strings.Trim(tld, "0123456789") == "" {
+		return errors.New("The value passed in was not a fully qualified domain name.")
+	}
+
+	return nil
+}
+
+// Check that a number or string is a port between 1 and 65535.
+func port(field string, value interface{}) error {
+
+	data := indirectValue(value)
+
+	var n int64
+	var err error
+
+	switch data.Kind() {
+	case reflect.String:
+		n, err = strconv.ParseInt(data.String(), 10, 64)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = data.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if data.Uint() > 65535 {
+			n = 65536
+		} else {
+			n = int64(data.Uint())
+		}
+	default:
+		return errors.New("The value passed in for PORT could not be converted to an int.")
+	}
+
+	if err != nil || n < 1 || n > 65535 {
+		return errors.New("The value passed in was not a valid port.")
+	}
+
+	return nil
+}
+
+// Check that a string or netip.AddrPort is a host and port such as
+// example.com:443 or [::1]:8080.
+func hostport(field string, value interface{}) error {
+
+	data := indirectValue(value)
+
+	switch {
+	case data.IsValid() && data.Type() == addrPortType:
+		if addrPort := data.Interface().(netip.AddrPort); addrPort.IsValid() && addrPort.Port() != 0 {
+			return nil
+		}
+		return errors.New("The value passed in was not a valid host and port.")
+	case data.Kind() != reflect.String:
+		return errors.New("The value passed in for HOSTPORT could not be converted to a string.")
+	}
+
+	host, p, err := net.SplitHostPort(data.String())
+	if err != nil {
+		return errors.New("The value passed in was not a valid host and port.")
+	}
+
+	if _, err := netip.ParseAddr(host); err != nil && !validHostname(host) {
+		return errors.New("The value passed in did not have a valid host.")
+	}
+
+	return port(field, &p)
+}
+
+// Report whether name is a host name as described by RFC 1123. Labels are one
+// to 63 letters, digits or hyphens and may not start or end with a hyphen.
+func validHostname(name string) bool {
+
+	if len(name) == 0 || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
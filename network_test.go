@@ -0,0 +1,87 @@
+package val
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestNetworkRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value string
+		valid bool
+	}{
+		{"ip", "192.168.0.1", true},
+		{"ip", "2001:db8::1", true},
+		{"ip", "256.1.1.1", false},
+		{"ip", "localhost", false},
+		{"ipv4", "10.0.0.1", true},
+		{"ipv4", "::1", false},
+		{"ipv6", "::1", true},
+		{"ipv6", "10.0.0.1", false},
+		{"cidr", "10.0.0.0/8", true},
+		{"cidr", "2001:db8::/32", true},
+		{"cidr", "10.0.0.0", false},
+		{"cidr", "10.0.0.0/33", false},
+		{"mac", "00:1a:2b:3c:4d:5e", true},
+		{"mac", "00-1A-2B-3C-4D-5E", true},
+		{"mac", "00:1a:2b", false},
+		{"hostname", "localhost", true},
+		{"hostname", "api-1.example.com", true},
+		{"hostname", "3com.com", true},
+		{"hostname", "-bad.example.com", false},
+		{"hostname", "bad_name.com", false},
+		{"hostname", "a..b", false},
+		{"fqdn", "example.com", true},
+		{"fqdn", "example.com.", true},
+		{"fqdn", "localhost", false},
+		{"fqdn", "10.0.0.1", false},
+		{"port", "443", true},
+		{"port", "0", false},
+		{"port", "65536", false},
+		{"port", "http", false},
+		{"hostport", "example.com:443", true},
+		{"hostport", "[::1]:8080", true},
+		{"hostport", "10.0.0.1:80", true},
+		{"hostport", "example.com", false},
+		{"hostport", "example.com:99999", false},
+		{"hostport", "bad_host:80", false},
+	}
+
+	for _, test := range tests {
+		value := test.value
+		err := check(testField, test.rule, &value, &value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %q returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestNetworkTypes(t *testing.T) {
+
+	type testServer struct {
+		Address  netip.Addr     `json:"address" validate:"required|ipv4"`
+		Network  netip.Prefix   `json:"network" validate:"cidr"`
+		Listen   netip.AddrPort `json:"listen" validate:"hostport"`
+		Port     *int           `json:"port" validate:"port"`
+		Hostname *string        `json:"hostname" validate:"hostname"`
+	}
+
+	var test testServer
+
+	body := `{"address": "10.0.0.1", "network": "10.0.0.0/8", "listen": "10.0.0.1:80", "port": 8080, "hostname": "web-1"}`
+
+	if err := Bind(jsonFactory(body), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testServer
+
+	body = `{"address": "::1", "listen": "10.0.0.1:0", "port": 0, "hostname": "web_1"}`
+
+	if errs, ok := Bind(jsonFactory(body), &test2).(Errors); !ok || len(errs) != 5 {
+		t.Errorf("Every field should fail but got %v.", errs)
+	}
+}
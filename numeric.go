@@ -0,0 +1,305 @@
+package val
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Numbers read from strings keep at most this many significant digits and
+// this large a decimal exponent, so a client can not make a rule build a
+// rational with millions of digits.
+const (
+	maxNumberDigits   = 1000
+	maxNumberExponent = 1000
+)
+
+// Check that a number is greater than the value passed in.
+func gt(field string, value interface{}) error {
+	return compare(field, value, "GT", func(c int) bool { return c > 0 }, "greater than")
+}
+
+// Check that a number is greater than or equal to the value passed in.
+func gte(field string, value interface{}) error {
+	return compare(field, value, "GTE", func(c int) bool { return c >= 0 }, "greater than or equal to")
+}
+
+// Check that a number is less than the value passed in.
+func lt(field string, value interface{}) error {
+	return compare(field, value, "LT", func(c int) bool { return c < 0 }, "less than")
+}
+
+// Check that a number is less than or equal to the value passed in.
+func lte(field string, value interface{}) error {
+	return compare(field, value, "LTE", func(c int) bool { return c <= 0 }, "less than or equal to")
+}
+
+// Check that a number is between the low and high values passed in,
+// including both.
+func between(field string, value interface{}) error {
+
+	bounds := params(field)
+	if len(bounds) != 2 {
+		return errors.New("BETWEEN requires exactly two paramaters.")
+	}
+
+	low, lowOk := new(big.Rat).SetString(bounds[0])
+	high, highOk := new(big.Rat).SetString(bounds[1])
+	if !lowOk || !highOk {
+		return errors.New("The value passed in for BETWEEN could not be converted to a number.")
+	}
+
+	n, _, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for BETWEEN could not be converted to a number.")
+	}
+
+	if n.Cmp(low) < 0 || n.Cmp(high) > 0 {
+		return errors.New("The value passed in was not between " + bounds[0] + " and " + bounds[1] + ".")
+	}
+
+	return nil
+}
+
+// Check that a number is above zero.
+func positive(field string, value interface{}) error {
+
+	n, _, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for POSITIVE could not be converted to a number.")
+	}
+
+	if n.Sign() <= 0 {
+		return errors.New("The value passed in was not positive.")
+	}
+
+	return nil
+}
+
+// Check that a number is below zero.
+func negative(field string, value interface{}) error {
+
+	n, _, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for NEGATIVE could not be converted to a number.")
+	}
+
+	if n.Sign() >= 0 {
+		return errors.New("The value passed in was not negative.")
+	}
+
+	return nil
+}
+
+// Check that a number is not zero.
+func nonzero(field string, value interface{}) error {
+
+	n, _, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for NONZERO could not be converted to a number.")
+	}
+
+	if n.Sign() == 0 {
+		return errors.New("The value passed in must not be zero.")
+	}
+
+	return nil
+}
+
+// Check that a number divides exactly by the value passed in, so
+// multiple_of:0.01 accepts 1.25 but not 1.255.
+func multiple_of(field string, value interface{}) error {
+
+	param := field[strings.Index(field, ":")+1:]
+
+	divisor, ok := new(big.Rat).SetString(param)
+	if !ok || divisor.Sign() == 0 {
+		return errors.New("The value passed in for MULTIPLE OF could not be converted to a number other than zero.")
+	}
+
+	n, exact, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for MULTIPLE OF could not be converted to a number.")
+	}
+
+	if !exact {
+		return errors.New("The value passed in has too many digits to be checked for MULTIPLE OF.")
+	}
+
+	if !new(big.Rat).Quo(n, divisor).IsInt() {
+		return errors.New("The value passed in was not a multiple of " + param + ".")
+	}
+
+	return nil
+}
+
+// Check that a number, usually a float or json.Number, is a whole number.
+func integer(field string, value interface{}) error {
+
+	n, exact, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for INTEGER could not be converted to a number.")
+	}
+
+	if !exact {
+		return errors.New("The value passed in has too many digits to be checked for INTEGER.")
+	}
+
+	if !n.IsInt() {
+		return errors.New("The value passed in was not a whole number.")
+	}
+
+	return nil
+}
+
+// Compare a number against the single value passed in, failing unless
+// accept is true for the result of the comparison.
+func compare(field string, value interface{}, name string, accept func(int) bool, relation string) error {
+
+	param := field[strings.Index(field, ":")+1:]
+
+	bound, ok := new(big.Rat).SetString(param)
+	if !ok {
+		return errors.New("The value passed in for " + name + " could not be converted to a number.")
+	}
+
+	n, _, ok := ratValue(value)
+	if !ok {
+		return errors.New("The value passed in for " + name + " could not be converted to a number.")
+	}
+
+	if !accept(n.Cmp(bound)) {
+		return errors.New("The value passed in was not " + relation + " " + param + ".")
+	}
+
+	return nil
+}
+
+// Read any number, or a string holding one such as a json.Number, as an
+// exact rational so large integers and decimals compare without the
+// rounding a float64 would introduce. Exact is false when a string was
+// past the limits above and the rational only stands in for it.
+func ratValue(value interface{}) (n *big.Rat, exact, ok bool) {
+
+	data := indirectValue(value)
+
+	switch data.Kind() {
+	case reflect.String:
+		return decimalValue(data.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(data.Int()), true, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(data.Uint())), true, true
+	case reflect.Float32, reflect.Float64:
+		n := new(big.Rat).SetFloat64(data.Float())
+		return n, true, n != nil
+	}
+
+	return nil, false, false
+}
+
+// Parse a decimal such as -12.5e3. A number with an exponent past
+// maxNumberExponent becomes plus or minus 10^1001 or 10^-1001, and one
+// with more than maxNumberDigits significant digits is cut short and
+// followed by a 5. Either way it still lands on the same side of any
+// bound written in a tag as the real number does.
+func decimalValue(s string) (n *big.Rat, exact, ok bool) {
+
+	i := 0
+	run := func() string {
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		return s[start:i]
+	}
+
+	negative := false
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		negative = s[i] == '-'
+		i++
+	}
+
+	whole, fraction := run(), ""
+	if i < len(s) && s[i] == '.' {
+		i++
+		fraction = run()
+	}
+
+	if whole == "" && fraction == "" {
+		return nil, false, false
+	}
+
+	exponent := 0
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		sign := 1
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			if s[i] == '-' {
+				sign = -1
+			}
+			i++
+		}
+
+		power := run()
+		if power == "" {
+			return nil, false, false
+		}
+
+		if power = strings.TrimLeft(power, "0"); len(power) > 9 {
+			power = "1000000000"
+		}
+
+		exponent, _ = strconv.Atoi(power)
+		exponent *= sign
+	}
+
+	if i != len(s) {
+		return nil, false, false
+	}
+
+	digits := strings.TrimLeft(whole+fraction, "0")
+	if digits == "" {
+		return new(big.Rat), true, true
+	}
+
+	// The number is digits * 10^point with no trailing zeros in digits.
+	point := exponent - len(fraction)
+	trimmed := strings.TrimRight(digits, "0")
+	point += len(digits) - len(trimmed)
+	digits = trimmed
+
+	exact = true
+
+	switch lead := point + len(digits) - 1; {
+	case lead > maxNumberExponent:
+		digits, point, exact = "1", maxNumberExponent+1, false
+	case lead < -maxNumberExponent:
+		digits, point, exact = "1", -maxNumberExponent-1, false
+	case len(digits) > maxNumberDigits:
+		point += len(digits) - maxNumberDigits - 1
+		digits, exact = digits[:maxNumberDigits]+"5", false
+	}
+
+	mantissa, _ := new(big.Int).SetString(digits, 10)
+
+	scale := point
+	if scale < 0 {
+		scale = -scale
+	}
+	power := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+
+	if point >= 0 {
+		n = new(big.Rat).SetInt(mantissa.Mul(mantissa, power))
+	} else {
+		n = new(big.Rat).SetFrac(mantissa, power)
+	}
+
+	if negative {
+		n.Neg(n)
+	}
+
+	return n, exact, true
+}
@@ -0,0 +1,140 @@
+package val
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNumericRules(t *testing.T) {
+
+	tests := []struct {
+		rule  string
+		value interface{}
+		valid bool
+	}{
+		{"gt:5", 6, true},
+		{"gt:5", 5, false},
+		{"gt:5", int8(6), true},
+		{"gt:5", uint16(4), false},
+		{"gt:0.5", float32(0.75), true},
+		{"gte:5", 5, true},
+		{"gte:5", 4.999, false},
+		{"lt:10", uint(9), true},
+		{"lt:10", 10.0, false},
+		{"lte:10", 10, true},
+		{"lte:10", json.Number("10.0001"), false},
+		{"gt:9007199254740992", json.Number("9007199254740993"), true},
+		{"gt:9007199254740992", uint64(9007199254740993), true},
+		{"lt:18446744073709551615", uint64(math.MaxUint64), false},
+		{"lte:-9223372036854775808", int64(math.MinInt64), true},
+		{"between:1,10", 1, true},
+		{"between:1,10", 10, true},
+		{"between:1,10", 10.5, false},
+		{"between:-1.5,1.5", json.Number("-1.5"), true},
+		{"between:1", 1, false},
+		{"positive", 1, true},
+		{"positive", 0, false},
+		{"positive", json.Number("-0.1"), false},
+		{"negative", -0.1, true},
+		{"negative", uint(0), false},
+		{"nonzero", 0.0, false},
+		{"nonzero", json.Number("0.000"), false},
+		{"nonzero", int16(-3), true},
+		{"multiple_of:5", 25, true},
+		{"multiple_of:5", 26, false},
+		{"multiple_of:0.01", json.Number("1.25"), true},
+		{"multiple_of:0.01", json.Number("1.255"), false},
+		{"multiple_of:0", 10, false},
+		{"integer", 3.0, true},
+		{"integer", 3.5, false},
+		{"integer", json.Number("12345678901234567890"), true},
+		{"integer", json.Number("1e3"), true},
+		{"integer", 7, true},
+		{"gt:5", "abc", false},
+		{"gt:five", 6, false},
+		{"positive", math.NaN(), false},
+		{"positive", math.Inf(1), false},
+		{"positive", json.Number("1/2"), false},
+		{"positive", true, false},
+		{"positive", json.Number("1e"), false},
+		{"positive", json.Number("0x10"), false},
+		{"positive", json.Number("1e-5"), true},
+	}
+
+	for _, test := range tests {
+		err := check(testField, test.rule, test.value, test.value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %v returned %v.", test.rule, test.value, err)
+		}
+	}
+}
+
+func TestNumericBind(t *testing.T) {
+
+	type testOrder struct {
+		Quantity *int        `json:"quantity" validate:"required|positive|lte:100"`
+		Price    json.Number `json:"price" validate:"required|gt:0|multiple_of:0.01"`
+		Discount float64     `json:"discount" validate:"between:0,1"`
+	}
+
+	var test testOrder
+
+	if err := Bind(jsonFactory(`{"quantity": 3, "price": 9.99, "discount": 0.25}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testOrder
+
+	if errs, ok := Bind(jsonFactory(`{"quantity": 0, "price": 9.999, "discount": 1.5}`), &test2).(Errors); !ok || len(errs) != 3 {
+		t.Errorf("Every field should fail but got %v.", errs)
+	}
+}
+
+func TestNumericLimits(t *testing.T) {
+
+	long := "1." + strings.Repeat("0", 5000) + "1"
+
+	tests := []struct {
+		rule  string
+		value interface{}
+		valid bool
+	}{
+		{"gt:0", json.Number("1e999999"), true},
+		{"gt:0", json.Number("1e5000000"), true},
+		{"lt:0", json.Number("-1e99999999999"), true},
+		{"lte:1000000", json.Number("1e5000000"), false},
+		{"positive", json.Number("1e-5000000"), true},
+		{"nonzero", json.Number("-1e-5000000"), true},
+		{"lt:0.000001", json.Number("1e-5000000"), true},
+		{"between:0,1", json.Number("1e-999999"), true},
+		{"gt:1", json.Number(long), true},
+		{"lte:1", json.Number(long), false},
+		{"gte:1e500", json.Number("1" + strings.Repeat("0", 500)), true},
+		{"integer", json.Number("1" + strings.Repeat("0", 500)), true},
+		{"integer", json.Number("1" + strings.Repeat("0", 5000)), false},
+		{"integer", json.Number("1e5000000"), false},
+		{"multiple_of:0.01", json.Number(long), false},
+	}
+
+	start := time.Now()
+
+	for _, test := range tests {
+		err := check(testField, test.rule, test.value, test.value, testParent)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s on %.20v returned %v.", test.rule, test.value, err)
+		}
+	}
+
+	if err := check(testField, "integer", json.Number("1e5000000"), json.Number("1e5000000"), testParent); err == nil || strings.Contains(err.Error(), "converted") {
+		t.Errorf("A number past the limits should fail the rule rather than conversion but got %v.", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Huge exponents should be cheap to check but took %v.", elapsed)
+	}
+}
@@ -0,0 +1,130 @@
+package val
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// Optional wraps a JSON value so that a key that was left out can be told
+// apart from one that was sent as null. Present is true when the key appeared
+// in the JSON at all and Null is true when it was sent as null. The rules
+// present and not_null check these states and every other rule is run
+// against Value when it was sent. A null value fails those other rules
+// unless the field is also nullable, in which case they are skipped.
+//
+//	var Profile struct {
+//	    Nickname val.Optional[string] `json:"nickname" validate:"nullable|length_between:2,20"`
+//	    Email    val.Optional[string] `json:"email" validate:"present|not_null|email"`
+//	}
+type Optional[T any] struct {
+	Value   T
+	Present bool
+	Null    bool
+}
+
+// Some returns an Optional holding value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{Value: value, Present: true}
+}
+
+// Get returns the value and whether it was sent and not null.
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Present && !o.Null
+}
+
+// UnmarshalJSON is only called when the key is in the JSON so it marks the
+// value as present before decoding it.
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+
+	o.Present = true
+
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		var zero T
+		o.Value = zero
+		o.Null = true
+		return nil
+	}
+
+	o.Null = false
+
+	return json.Unmarshal(b, &o.Value)
+}
+
+// MarshalJSON writes null for values that are missing or null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+
+	if !o.Present || o.Null {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.Value)
+}
+
+func (o Optional[T]) isPresent() bool {
+	return o.Present
+}
+
+func (o Optional[T]) isNull() bool {
+	return o.Null
+}
+
+// Lets validateStruct spot an Optional of any type.
+type optional interface {
+	isPresent() bool
+	isNull() bool
+}
+
+var optionalType = reflect.TypeOf((*optional)(nil)).Elem()
+
+// Run the rules of an Optional field. Value is the Optional itself and
+// parent the struct holding it.
+func validateOptional(value reflect.Value, plan fieldPlan, path string, tags []string, parent reflect.Value) Errors {
+
+	var errs Errors
+
+	opt := value.Interface().(optional)
+	inner := value.FieldByName("Value")
+	name := joinPath(path, plan.name)
+
+	// Structs held by an Optional are validated like any other nested struct.
+	if nested, ok := nestedStruct(inner); ok && opt.isPresent() && !opt.isNull() {
+		errs = validateStruct(nested, name, tags)
+	}
+
+	for _, match := range plan.rules {
+
+		var err error
+
+		switch {
+		case match == "nullable":
+			continue
+		case match == "present":
+			if !opt.isPresent() {
+				err = errors.New("The field " + plan.field.Name + " was not submitted.")
+			}
+		case match == "not_null":
+			if opt.isNull() {
+				err = errors.New("The field " + plan.field.Name + " can not be null.")
+			}
+		case match == "required" || match == "required:allow_zero":
+			if !opt.isPresent() || opt.isNull() {
+				err = errors.New("The required field " + plan.field.Name + " was not submitted.")
+			}
+		case !opt.isPresent() || (opt.isNull() && plan.nullable):
+			// Left out or allowed to be null so there is nothing to check.
+			return errs
+		case opt.isNull():
+			err = errors.New("The field " + plan.field.Name + " can not be null.")
+		default:
+			err = check(plan.field, match, pointerTo(inner), inner.Interface(), parent)
+		}
+
+		if err != nil {
+			return append(errs, &FieldError{Field: name, Rule: match, Err: err})
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,84 @@
+package val
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testProfile struct {
+	Nickname Optional[string] `json:"nickname" validate:"nullable|length_between:2,5"`
+	Email    Optional[string] `json:"email" validate:"present|not_null|email"`
+	Age      Optional[int]    `json:"age" validate:"min:18"`
+}
+
+func TestOptional(t *testing.T) {
+
+	var testNull testProfile
+
+	if err := Bind(jsonFactory(`{"nickname": null, "email": "m@gmail.com"}`), &testNull); err != nil {
+		t.Error(err)
+	}
+
+	if !testNull.Nickname.Present || !testNull.Nickname.Null || testNull.Age.Present {
+		t.Errorf("Nickname should be present and null, age should be missing but got %+v.", testNull)
+	}
+
+	if _, ok := testNull.Nickname.Get(); ok {
+		t.Error("Get should report a null value as not set.")
+	}
+
+	tests := map[string]string{
+		`{"nickname": "mj"}`: "email",
+		`{"email": null}`:    "email",
+		`{"email": "m@gmail.com", "nickname": "michael"}`:    "nickname",
+		`{"email": "m@gmail.com", "age": null}`:              "age",
+		`{"email": "m@gmail.com", "age": 12}`:                "age",
+		`{"email": "m@gmail.com", "age": 20, "nickname": 5}`: "",
+	}
+
+	for body, field := range tests {
+		var test testProfile
+
+		err := Bind(jsonFactory(body), &test)
+
+		if field == "" {
+			if err == nil {
+				t.Errorf("%s should have failed to decode.", body)
+			}
+			continue
+		}
+
+		if errs, ok := err.(Errors); !ok || len(errs) != 1 || errs[0].Field != field {
+			t.Errorf("%s should only fail on %s but got %v.", body, field, err)
+		}
+	}
+}
+
+func TestOptionalMarshal(t *testing.T) {
+
+	test := struct {
+		A Optional[string] `json:"a"`
+		B Optional[string] `json:"b"`
+	}{A: Some("x")}
+
+	b, err := json.Marshal(test)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"a":"x","b":null}` {
+		t.Errorf("Unexpected JSON %s.", b)
+	}
+}
+
+// Outside of an Optional present and not_null act like required.
+func TestPresenceRules(t *testing.T) {
+
+	var test struct {
+		Name *string `json:"name" validate:"not_null|nullable"`
+	}
+
+	if err := Bind(jsonFactory(`{"name": null}`), &test); err == nil {
+		t.Error("not_null on a nil pointer should return an error.")
+	}
+}
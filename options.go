@@ -0,0 +1,78 @@
+package val
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+)
+
+// ErrTooLarge is returned when a body is larger than the limit set with MaxBytes.
+var ErrTooLarge = errors.New("The body passed in was larger than the maximum allowed size.")
+
+// Option changes how Bind, BindXML and StreamValidator decode their input.
+type Option func(*options)
+
+type options struct {
+	disallowUnknownFields bool
+	maxBytes              int64
+	minItems              int
+	maxItems              int
+}
+
+// DisallowUnknownFields makes decoding fail when the body contains a key or
+// element that does not map to a field of the destination struct.
+func DisallowUnknownFields() Option {
+	return func(o *options) {
+		o.disallowUnknownFields = true
+	}
+}
+
+// MaxBytes makes decoding fail with ErrTooLarge when the body is larger than n bytes.
+func MaxBytes(n int64) Option {
+	return func(o *options) {
+		o.maxBytes = n
+	}
+}
+
+// MinItems makes Bind return an error when a top level JSON array has fewer than n items.
+func MinItems(n int) Option {
+	return func(o *options) {
+		o.minItems = n
+	}
+}
+
+// MaxItems makes Bind return an error when a top level JSON array has more than n items.
+func MaxItems(n int) Option {
+	return func(o *options) {
+		o.maxItems = n
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Read all of input while enforcing the size limit if one was set.
+func readBody(input io.Reader, o options) ([]byte, error) {
+
+	if o.maxBytes <= 0 {
+		return ioutil.ReadAll(input)
+	}
+
+	b, err := ioutil.ReadAll(io.LimitReader(input, o.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(b)) > o.maxBytes {
+		return nil, ErrTooLarge
+	}
+
+	return b, nil
+}
@@ -0,0 +1,82 @@
+package val
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// A fieldPlan holds everything about a struct field that validateStruct needs
+// and that only depends on its type, so tags are only parsed once per type.
+type fieldPlan struct {
+	index int
+	field reflect.StructField
+	name  string
+
+	// Embedded structs without a name of their own share the parent's path.
+	flatten bool
+
+	// The field is a slice or array of structs that need validating.
+	elements bool
+
+	// The field is an Optional and null is allowed through the nullable rule.
+	optional bool
+	nullable bool
+
+	rules []string
+}
+
+type planKey struct {
+	typ  reflect.Type
+	tags string
+}
+
+// Plans for every struct type validated so far keyed by type and the tags
+// used to name fields. Safe for use from many goroutines at once.
+var plans sync.Map
+
+// Return the plan for a struct type, building and caching it on first use.
+func planFor(typ reflect.Type, tags []string) []fieldPlan {
+
+	key := planKey{typ: typ, tags: strings.Join(tags, ",")}
+
+	if plan, ok := plans.Load(key); ok {
+		return plan.([]fieldPlan)
+	}
+
+	var plan []fieldPlan
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+
+		// Unexported fields can not be read through reflection so skip
+		// them, this also keeps us out of the internals of types like time.Time.
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, tagged := fieldName(field, tags)
+		if source, ok := sourceName(field); ok {
+			name, tagged = source, true
+		}
+
+		assertions := rules(field)
+
+		plan = append(plan, fieldPlan{
+			index:    i,
+			field:    field,
+			name:     name,
+			flatten:  field.Anonymous && !tagged,
+			elements: structElements(indirectType(field.Type)),
+			optional: field.Type.Kind() == reflect.Struct && field.Type.Implements(optionalType),
+			nullable: hasFlag(assertions, "nullable"),
+			rules:    assertions,
+		})
+	}
+
+	// Another goroutine may have got here first, either plan is fine.
+	actual, _ := plans.LoadOrStore(key, plan)
+
+	return actual.([]fieldPlan)
+}
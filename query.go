@@ -0,0 +1,24 @@
+package val
+
+import (
+	"net/url"
+)
+
+// BindQuery fills obj from URL query parameters and then validates it. Keys are
+// taken from the query tag, falling back to the json tag and then the field name.
+// Values are converted to strings, bools, ints, floats, times, durations or
+// slices of those, with repeated keys filling a slice.
+//
+//	var Params struct {
+//	    Page    *int    `query:"page" validate:"min:1"`
+//	    PerPage *int    `query:"per_page" validate:"max:100"`
+//	    Sort    *string `query:"sort" validate:"in:name,date"`
+//	}
+//
+//	err := val.BindQuery(r.URL.Query(), &Params)
+func BindQuery(values url.Values, obj interface{}) error {
+	return bindAndValidate(obj, []string{"query", "json"}, func(key string) ([]string, bool) {
+		raw, ok := values[key]
+		return raw, ok
+	})
+}
@@ -0,0 +1,82 @@
+package val
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestBindQuery(t *testing.T) {
+
+	var testQuery struct {
+		Page    *int          `query:"page" validate:"required|min:1"`
+		PerPage int           `query:"per_page" validate:"max:100"`
+		Sort    *string       `json:"sort" validate:"in:name,date"`
+		Tags    []string      `query:"tag"`
+		Active  *bool         `query:"active"`
+		Ratio   float64       `query:"ratio"`
+		Since   *time.Time    `query:"since"`
+		Timeout time.Duration `query:"timeout"`
+		Ignored *string       `query:"-"`
+	}
+
+	values, _ := url.ParseQuery("page=2&per_page=50&sort=name&tag=a&tag=b&active=true&ratio=0.5&since=2016-01-02&timeout=1m30s&Ignored=x")
+
+	if err := BindQuery(values, &testQuery); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testQuery.Page != 2 || testQuery.PerPage != 50 || *testQuery.Sort != "name" {
+		t.Error("Page, per_page and sort were not bound from the query string.")
+	}
+
+	if len(testQuery.Tags) != 2 || testQuery.Tags[0] != "a" || testQuery.Tags[1] != "b" {
+		t.Errorf("Repeated tag keys should fill the slice but got %v.", testQuery.Tags)
+	}
+
+	if !*testQuery.Active || testQuery.Ratio != 0.5 || testQuery.Timeout != 90*time.Second {
+		t.Error("Bool, float or duration values were not bound from the query string.")
+	}
+
+	if !testQuery.Since.Equal(time.Date(2016, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Since was bound as %v.", testQuery.Since)
+	}
+
+	if testQuery.Ignored != nil {
+		t.Error("Fields tagged with - should not be bound.")
+	}
+}
+
+func TestBindQueryErrors(t *testing.T) {
+
+	var testQuery struct {
+		Page    *int    `query:"page" validate:"required|min:1"`
+		PerPage *int    `query:"per_page" validate:"max:100"`
+		Sort    *string `query:"sort" validate:"in:name,date"`
+	}
+
+	values, _ := url.ParseQuery("page=abc&per_page=500&sort=name")
+
+	err := BindQuery(values, &testQuery)
+
+	errs, ok := err.(Errors)
+	if !ok {
+		t.Fatalf("BindQuery should have returned Errors but returned %v.", err)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected an error for page and per_page but got %v.", errs)
+	}
+
+	if errs[0].Field != "page" || errs[0].Rule != "" {
+		t.Errorf("Expected a conversion error for page but got %v.", errs[0])
+	}
+
+	if errs[1].Field != "per_page" || errs[1].Rule != "max:100" {
+		t.Errorf("Expected per_page to fail max:100 but got %v.", errs[1])
+	}
+
+	if err := BindQuery(values, testQuery); err == nil {
+		t.Error("Passing a struct by value should return an error since it can not be filled in.")
+	}
+}
@@ -0,0 +1,150 @@
+package val
+
+import (
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// UnsupportedMediaTypeError is returned by BindRequest when the Content-Type
+// of a request is not one it knows how to decode. ContentType holds the
+// header as it was sent.
+type UnsupportedMediaTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedMediaTypeError) Error() string {
+	if e.ContentType == "" {
+		return "The request did not include a Content-Type."
+	}
+
+	return "The Content-Type " + e.ContentType + " is not supported."
+}
+
+// StatusCode returns the HTTP status to respond with, 415 Unsupported Media Type.
+func (e *UnsupportedMediaTypeError) StatusCode() int {
+	return http.StatusUnsupportedMediaType
+}
+
+// BindRequest decodes the body of r in to obj based on its Content-Type and
+// then validates it. JSON, url encoded forms, multipart forms and XML are
+// supported, anything else returns an *UnsupportedMediaTypeError. A request
+// without a body or Content-Type skips decoding and is only validated.
+//
+// Fields tagged with header or path are filled from the request's headers and
+// from r.PathValue so that one struct can describe the whole request. Errors
+// for those fields are named after their source, e.g. header.X-Request-ID.
+// They are bound after the body so the body can never overwrite them.
+//
+//	var Update struct {
+//	    RequestID *string `header:"X-Request-ID" validate:"required|uuid"`
+//	    ID        *int    `path:"id" validate:"required|min:1"`
+//	    Name      *string `json:"name" validate:"required"`
+//	}
+//
+//	if err := val.BindRequest(r, &Update); err != nil {
+//	    if _, ok := err.(*val.UnsupportedMediaTypeError); ok {
+//	        w.WriteHeader(http.StatusUnsupportedMediaType)
+//	    }
+//	}
+func BindRequest(r *http.Request, obj interface{}) error {
+
+	value, err := settable(obj)
+	if err != nil {
+		return err
+	}
+
+	tags, errs, err := decodeBody(r, obj, value)
+	if err != nil {
+		return err
+	}
+
+	errs = append(errs, bindSource(value, "header", func(key string) ([]string, bool) {
+		raw := r.Header.Values(key)
+		return raw, len(raw) > 0
+	})...)
+
+	errs = append(errs, bindSource(value, "path", func(key string) ([]string, bool) {
+		raw := r.PathValue(key)
+		return []string{raw}, raw != ""
+	})...)
+
+	return merge(errs, validateStruct(value, "", tags)).err()
+}
+
+// Decode the body of r in to obj based on its Content-Type, returning the
+// tags its fields are named by and any fields that failed to convert.
+func decodeBody(r *http.Request, obj interface{}, value reflect.Value) ([]string, Errors, error) {
+
+	contentType := r.Header.Get("Content-Type")
+
+	if contentType == "" && (r.Body == nil || r.Body == http.NoBody) {
+		return []string{"json"}, nil, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, nil, &UnsupportedMediaTypeError{ContentType: contentType}
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		// An empty body may be fine when the fields come from headers or
+		// the path, leave it to validation to decide.
+		if err := decodeJSON(r.Body, obj, options{}); err != nil && err != errNothingPassed {
+			return nil, nil, err
+		}
+		return []string{"json"}, nil, nil
+	case mediaType == "application/x-www-form-urlencoded" || mediaType == "multipart/form-data":
+		errs, err := decodeForm(r, value)
+		return formTags, errs, err
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		_, err := decodeXML(r.Body, obj, options{})
+		return []string{"xml"}, nil, err
+	}
+
+	return nil, nil, &UnsupportedMediaTypeError{ContentType: contentType}
+}
+
+// Fill every field tagged with source using lookup, clearing any the source
+// does not have. Unlike bindValues only tagged fields are considered and
+// errors are named after the source.
+func bindSource(value reflect.Value, source string, lookup func(key string) ([]string, bool)) Errors {
+
+	var errs Errors
+	typ := value.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			errs = append(errs, bindSource(value.Field(i), source, lookup)...)
+			continue
+		}
+
+		key := field.Tag.Get(source)
+		if key == "" || key == "-" {
+			continue
+		}
+
+		// Only the source may set the field, even if the body held it.
+		value.Field(i).Set(reflect.Zero(field.Type))
+
+		raw, ok := lookup(key)
+		if !ok {
+			continue
+		}
+
+		if err := setValue(value.Field(i), raw); err != nil {
+			errs = append(errs, &FieldError{Field: source + "." + key, Err: err})
+		}
+	}
+
+	return errs
+}
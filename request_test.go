@@ -0,0 +1,181 @@
+package val
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBindRequest(t *testing.T) {
+
+	type testRequest struct {
+		Name *string `json:"name" xml:"name" form:"name" validate:"required|in:michael,greg"`
+	}
+
+	bodies := map[string]string{
+		"application/json":                  `{"name": "michael"}`,
+		"application/json; charset=utf-8":   `{"name": "michael"}`,
+		"application/vnd.api+json":          `{"name": "michael"}`,
+		"application/x-www-form-urlencoded": `name=michael`,
+		"application/xml":                   `<testRequest><name>michael</name></testRequest>`,
+		"text/xml; charset=utf-8":           `<testRequest><name>michael</name></testRequest>`,
+	}
+
+	for contentType, body := range bodies {
+		var test testRequest
+
+		req, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+
+		if err := BindRequest(req, &test); err != nil {
+			t.Errorf("%s: %v", contentType, err)
+		} else if test.Name == nil || *test.Name != "michael" {
+			t.Errorf("%s: name was not bound.", contentType)
+		}
+	}
+
+	var testInvalid testRequest
+
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(`<testRequest><name>jeff</name></testRequest>`))
+	req.Header.Set("Content-Type", "application/xml")
+
+	if err := BindRequest(req, &testInvalid); err == nil {
+		t.Error("XML bodies should be validated after decoding.")
+	}
+
+	req = multipartFactory(map[string]string{"name": "greg"}, "", "", nil)
+
+	if err := BindRequest(req, &testInvalid); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindRequestUnsupported(t *testing.T) {
+
+	var test struct {
+		Name *string `json:"name"`
+	}
+
+	for _, contentType := range []string{"text/plain", "", "application/octet-stream", "not a type;;"} {
+		req, _ := http.NewRequest("POST", "/", strings.NewReader("michael"))
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		err, ok := BindRequest(req, &test).(*UnsupportedMediaTypeError)
+		if !ok {
+			t.Errorf("%q should have returned an UnsupportedMediaTypeError.", contentType)
+			continue
+		}
+
+		if err.ContentType != contentType || err.StatusCode() != http.StatusUnsupportedMediaType {
+			t.Errorf("%q returned %v with status %d.", contentType, err, err.StatusCode())
+		}
+	}
+}
+
+func TestBindRequestHeadersAndPath(t *testing.T) {
+
+	type testUpdate struct {
+		RequestID *string `header:"X-Request-ID" validate:"required|length:36"`
+		ID        int     `path:"id" validate:"min:1"`
+		Name      *string `json:"name" validate:"required"`
+	}
+
+	var test testUpdate
+
+	req, _ := http.NewRequest("PUT", "/users/5", strings.NewReader(`{"name": "michael"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	req.SetPathValue("id", "5")
+
+	if err := BindRequest(req, &test); err != nil {
+		t.Fatal(err)
+	}
+
+	if test.ID != 5 || *test.RequestID != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" || *test.Name != "michael" {
+		t.Error("Header, path and body values were not all bound.")
+	}
+
+	var test2 testUpdate
+
+	req, _ = http.NewRequest("PUT", "/users/abc", strings.NewReader(`{"name": "michael"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("id", "abc")
+
+	errs, ok := BindRequest(req, &test2).(Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected errors for the header and path but got %v.", errs)
+	}
+
+	if errs[0].Field != "path.id" || errs[1].Field != "header.X-Request-ID" || errs[1].Rule != "required" {
+		t.Errorf("Errors should be prefixed with their source but got %v.", errs)
+	}
+
+	// Without a body only the headers and path are bound.
+	var test3 struct {
+		ID int `path:"id" validate:"min:1"`
+	}
+
+	req, _ = http.NewRequest("GET", "/users/0", nil)
+	req.SetPathValue("id", "0")
+
+	if errs, ok := BindRequest(req, &test3).(Errors); !ok || errs[0].Field != "path.id" {
+		t.Errorf("A path value of 0 should fail min:1 but got %v.", errs)
+	}
+
+	// An empty JSON object is left to validation rather than rejected.
+	var test4 struct {
+		RequestID *string `header:"X-Request-ID" validate:"required"`
+		ID        int     `path:"id" validate:"min:1"`
+	}
+
+	req, _ = http.NewRequest("DELETE", "/users/5", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	req.SetPathValue("id", "5")
+
+	if err := BindRequest(req, &test4); err != nil {
+		t.Errorf("A struct without body fields should accept {} but got %v.", err)
+	}
+}
+
+func TestBindRequestBodyCannotSetHeaders(t *testing.T) {
+
+	type testUpdate struct {
+		RequestID *string `header:"X-Request-ID" validate:"required|uuid"`
+		ID        int     `path:"id" xml:"ID" validate:"min:1"`
+		Name      *string `json:"name" xml:"name" validate:"required"`
+	}
+
+	var test testUpdate
+
+	req, _ := http.NewRequest("PUT", "/users/5", strings.NewReader(`{"name": "michael", "RequestID": "not-a-uuid-but-from-body", "ID": 9}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-ID", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	req.SetPathValue("id", "5")
+
+	if err := BindRequest(req, &test); err != nil {
+		t.Fatal(err)
+	}
+
+	if *test.RequestID != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" || test.ID != 5 {
+		t.Errorf("The header and path should win over the body but got %q and %d.", *test.RequestID, test.ID)
+	}
+
+	// Without the header the body still can not fill the field.
+	var test2 testUpdate
+
+	req, _ = http.NewRequest("PUT", "/users/5", strings.NewReader(`<testUpdate><name>michael</name><ID>9</ID></testUpdate>`))
+	req.Header.Set("Content-Type", "application/xml")
+	req.SetPathValue("id", "5")
+
+	errs, ok := BindRequest(req, &test2).(Errors)
+	if !ok || len(errs) != 1 || errs[0].Field != "header.X-Request-ID" || errs[0].Rule != "required" {
+		t.Errorf("A missing header should fail required even when the body sets the field but got %v.", errs)
+	}
+
+	if test2.ID != 5 {
+		t.Errorf("The path should win over the body but got %d.", test2.ID)
+	}
+}
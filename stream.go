@@ -0,0 +1,165 @@
+package val
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+)
+
+// ErrTooManyErrors is returned by a StreamValidator that stopped early because
+// it reached its MaxErrors limit.
+var ErrTooManyErrors = errors.New("Too many invalid records were found, the rest of the stream was not read.")
+
+// Longest line a StreamValidator will read unless MaxBytes is passed.
+const defaultMaxLineBytes = 1 << 20
+
+// Record is the result of decoding and validating one line of a stream.
+// Err holds the decoding error or the Errors returned by validation and is
+// nil when the record is valid.
+type Record[T any] struct {
+	Line  int
+	Value T
+	Err   error
+}
+
+// StreamValidator reads newline delimited JSON one record at a time, decoding
+// each line in to a fresh T and validating it. Only a single line is held in
+// memory at once so streams of any size can be checked. Blank lines are
+// skipped but still counted so line numbers match the input.
+//
+//	stream := val.NewStreamValidator[Item](r.Body, val.MaxBytes(64<<10))
+//	stream.MaxErrors = 100
+//
+//	for record := range stream.Records() {
+//	    if record.Err != nil {
+//	        fmt.Println(record.Line, record.Err)
+//	    }
+//	}
+//
+//	if err := stream.Err(); err != nil {
+//	    fmt.Println(err)
+//	}
+type StreamValidator[T any] struct {
+	// MaxErrors stops the stream once this many invalid records have been
+	// seen, Err then returns ErrTooManyErrors. Zero means no limit.
+	MaxErrors int
+
+	scanner *bufio.Scanner
+	options options
+	line    int
+	invalid int
+	err     error
+}
+
+// NewStreamValidator returns a StreamValidator reading from input. MaxBytes
+// limits the length of a single line (1MB by default) and
+// DisallowUnknownFields rejects records with keys that do not map to a field.
+func NewStreamValidator[T any](input io.Reader, opts ...Option) *StreamValidator[T] {
+
+	o := newOptions(opts)
+
+	max := defaultMaxLineBytes
+	if o.maxBytes > 0 {
+		max = int(o.maxBytes)
+	}
+
+	// The scanner needs room for the newline after a line of max bytes and
+	// only enforces its limit when it grows the buffer, so never start with
+	// a buffer larger than that.
+	limit := max + 1
+	size := 4096
+	if size > limit {
+		size = limit
+	}
+
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, size), limit)
+
+	return &StreamValidator[T]{scanner: scanner, options: o}
+}
+
+// Records returns an iterator over every record in the stream. Stopping the
+// loop early leaves the rest of the stream unread.
+func (s *StreamValidator[T]) Records() iter.Seq[Record[T]] {
+	return func(yield func(Record[T]) bool) {
+
+		for s.err == nil && s.scanner.Scan() {
+			s.line++
+
+			b := bytes.TrimSpace(s.scanner.Bytes())
+			if len(b) == 0 {
+				continue
+			}
+
+			record := Record[T]{Line: s.line}
+			record.Err = s.decode(b, &record.Value)
+
+			if record.Err != nil {
+				s.invalid++
+			}
+
+			if !yield(record) {
+				return
+			}
+
+			if s.MaxErrors > 0 && s.invalid >= s.MaxErrors {
+				s.err = ErrTooManyErrors
+			}
+		}
+
+		if err := s.scanner.Err(); err == bufio.ErrTooLong {
+			s.err = ErrTooLarge
+		} else if err != nil {
+			s.err = err
+		}
+	}
+}
+
+// Each calls fn with every record in the stream, stopping at the first
+// error fn returns. It returns that error or the error from Err.
+func (s *StreamValidator[T]) Each(fn func(Record[T]) error) error {
+
+	for record := range s.Records() {
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+
+	return s.Err()
+}
+
+// Err returns the error that stopped the stream early, such as a read error,
+// a line longer than the limit or ErrTooManyErrors. Invalid records on their
+// own are reported through Record.Err and do not set it.
+func (s *StreamValidator[T]) Err() error {
+	return s.err
+}
+
+// Invalid returns how many invalid records have been read so far.
+func (s *StreamValidator[T]) Invalid() int {
+	return s.invalid
+}
+
+func (s *StreamValidator[T]) decode(b []byte, value *T) error {
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+
+	if s.options.disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(value); err != nil {
+		return err
+	}
+
+	// Anything but whitespace after the value means the line was not a
+	// single record.
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("The line held more than a single JSON value.")
+	}
+
+	return Validate(value)
+}
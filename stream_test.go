@@ -0,0 +1,102 @@
+package val
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testStreamItem struct {
+	ID    *int    `json:"id" validate:"required|min:1"`
+	Email *string `json:"email" validate:"email"`
+}
+
+func TestStreamValidator(t *testing.T) {
+
+	input := `{"id": 1, "email": "m@gmail.com"}
+{"id": 0}
+
+{"id": 3, "email": "not an email"}
+{"id":
+{"id": 5}
+{"id": 6} {"id": 7}
+{"id": 8} garbage
+{"id": 9}  
+`
+
+	stream := NewStreamValidator[testStreamItem](strings.NewReader(input))
+
+	var lines []int
+	var invalid []int
+
+	for record := range stream.Records() {
+		lines = append(lines, record.Line)
+		if record.Err != nil {
+			invalid = append(invalid, record.Line)
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(lines) != 8 || lines[2] != 4 {
+		t.Errorf("Blank lines should be skipped but counted, got lines %v.", lines)
+	}
+
+	if len(invalid) != 5 || invalid[0] != 2 || invalid[1] != 4 || invalid[2] != 5 || invalid[3] != 7 || invalid[4] != 8 || stream.Invalid() != 5 {
+		t.Errorf("Expected lines 2, 4, 5, 7 and 8 to be invalid but got %v.", invalid)
+	}
+}
+
+func TestStreamValidatorLimits(t *testing.T) {
+
+	input := strings.Repeat(`{"id": 0}`+"\n", 10)
+
+	stream := NewStreamValidator[*testStreamItem](strings.NewReader(input))
+	stream.MaxErrors = 3
+
+	count := 0
+	err := stream.Each(func(record Record[*testStreamItem]) error {
+		count++
+		return nil
+	})
+
+	if err != ErrTooManyErrors || count != 3 {
+		t.Errorf("Stream should stop after 3 errors but read %d records and returned %v.", count, err)
+	}
+
+	stream2 := NewStreamValidator[testStreamItem](strings.NewReader(`{"id": 1, "email": "m@gmail.com"}`), MaxBytes(10))
+
+	if err := stream2.Each(func(Record[testStreamItem]) error { return nil }); err != ErrTooLarge {
+		t.Errorf("A line over the limit should return ErrTooLarge but got %v.", err)
+	}
+
+	// MaxBytes is the longest line allowed, not the first one rejected.
+	exact := NewStreamValidator[testStreamItem](strings.NewReader(`{"id": 1}`+"\n"+`{"id": 2}`), MaxBytes(9))
+	lines := 0
+
+	if err := exact.Each(func(Record[testStreamItem]) error { lines++; return nil }); err != nil || lines != 2 {
+		t.Errorf("Lines of exactly MaxBytes should be read but got %d lines and %v.", lines, err)
+	}
+
+	over := NewStreamValidator[testStreamItem](strings.NewReader(`{"id": 10}`), MaxBytes(9))
+
+	if err := over.Each(func(Record[testStreamItem]) error { return nil }); err != ErrTooLarge {
+		t.Errorf("A line one byte over the limit should return ErrTooLarge but got %v.", err)
+	}
+
+	stream3 := NewStreamValidator[testStreamItem](strings.NewReader(`{"id": 1, "name": "m"}`), DisallowUnknownFields())
+	stop := errors.New("stop")
+
+	err = stream3.Each(func(record Record[testStreamItem]) error {
+		if record.Err == nil {
+			t.Error("The unknown name key should make the record invalid.")
+		}
+		return stop
+	})
+
+	if err != stop {
+		t.Errorf("Each should return the error from fn but returned %v.", err)
+	}
+}
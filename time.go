@@ -0,0 +1,279 @@
+package val
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Now is the clock used by rules that compare against the current time such
+// as before:now or after:+24h. Replace it in tests to get repeatable results.
+var Now = time.Now
+
+// Check that a string matches the time layout passed in, e.g. date_format:2006-01-02.
+func date_format(field string, value interface{}) error {
+
+	layout := field[strings.Index(field, ":")+1:]
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for DATE FORMAT could not be converted to a string.")
+	}
+
+	if _, err := time.Parse(layout, data); err != nil {
+		return errors.New("The value passed in did not match the date format " + layout + ".")
+	}
+
+	return nil
+}
+
+// Check that a time is before the one passed in. See parseMoment for what
+// can be passed in.
+func before(field string, value interface{}) error {
+
+	limit, err := parseMoment(field[strings.Index(field, ":")+1:])
+	if err != nil {
+		return err
+	}
+
+	data, err := timeValue(value)
+	if err != nil {
+		return err
+	}
+
+	if !data.Before(limit) {
+		return errors.New("The time passed in was not before " + limit.Format(time.RFC3339) + ".")
+	}
+
+	return nil
+}
+
+// Check that a time is after the one passed in. See parseMoment for what
+// can be passed in.
+func after(field string, value interface{}) error {
+
+	limit, err := parseMoment(field[strings.Index(field, ":")+1:])
+	if err != nil {
+		return err
+	}
+
+	data, err := timeValue(value)
+	if err != nil {
+		return err
+	}
+
+	if !data.After(limit) {
+		return errors.New("The time passed in was not after " + limit.Format(time.RFC3339) + ".")
+	}
+
+	return nil
+}
+
+// Check that a time is before the time held by another field of the same
+// struct, named by its Go name or its json tag. Passes when the other field
+// is not set.
+func before_field(field string, value interface{}, parent reflect.Value) error {
+
+	other, ok, err := siblingTime(field, parent)
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := timeValue(value)
+	if err != nil {
+		return err
+	}
+
+	if !data.Before(other) {
+		return errors.New("The time passed in was not before " + field[strings.Index(field, ":")+1:] + ".")
+	}
+
+	return nil
+}
+
+// Check that a time is after the time held by another field of the same
+// struct, named by its Go name or its json tag. Passes when the other field
+// is not set.
+func after_field(field string, value interface{}, parent reflect.Value) error {
+
+	other, ok, err := siblingTime(field, parent)
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := timeValue(value)
+	if err != nil {
+		return err
+	}
+
+	if !data.After(other) {
+		return errors.New("The time passed in was not after " + field[strings.Index(field, ":")+1:] + ".")
+	}
+
+	return nil
+}
+
+// Check that a time.Duration, or a string holding one such as 1h30m, is a
+// valid duration.
+func duration(field string, value interface{}) error {
+
+	if _, err := durationValue(value); err != nil {
+		return errors.New("The value passed in was not a valid duration.")
+	}
+
+	return nil
+}
+
+// Check that a duration is at least as long as the one passed in.
+func min_duration(field string, value interface{}) error {
+
+	limit, err := time.ParseDuration(field[strings.Index(field, ":")+1:])
+	if err != nil {
+		return errors.New("The value passed in for MIN DURATION could not be converted to a duration.")
+	}
+
+	data, err := durationValue(value)
+	if err != nil {
+		return err
+	}
+
+	if data < limit {
+		return errors.New("The duration passed in was shorter than " + limit.String() + ".")
+	}
+
+	return nil
+}
+
+// Check that a duration is no longer than the one passed in.
+func max_duration(field string, value interface{}) error {
+
+	limit, err := time.ParseDuration(field[strings.Index(field, ":")+1:])
+	if err != nil {
+		return errors.New("The value passed in for MAX DURATION could not be converted to a duration.")
+	}
+
+	data, err := durationValue(value)
+	if err != nil {
+		return err
+	}
+
+	if data > limit {
+		return errors.New("The duration passed in was longer than " + limit.String() + ".")
+	}
+
+	return nil
+}
+
+// Turn the parameter of before or after in to a time. It can be an absolute
+// time in one of timeLayouts, now, or now plus or minus a duration written as
+// now+24h, +24h or -1h30m. Relative times are taken from Now.
+func parseMoment(moment string) (time.Time, error) {
+
+	moment = strings.TrimSpace(moment)
+	relative := strings.TrimPrefix(moment, "now")
+
+	if relative == "" {
+		return Now(), nil
+	}
+
+	if relative[0] == '+' || relative[0] == '-' {
+		d, err := time.ParseDuration(relative)
+		if err != nil {
+			return time.Time{}, errors.New("The time " + moment + " could not be understood.")
+		}
+		return Now().Add(d), nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, moment); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("The time " + moment + " could not be understood.")
+}
+
+// Find the time held by the field of parent named in the rule. Ok is
+// false when that field is nil.
+func siblingTime(field string, parent reflect.Value) (time.Time, bool, error) {
+
+	name := field[strings.Index(field, ":")+1:]
+
+	sibling, found := siblingField(parent, name)
+	if !found {
+		return time.Time{}, false, errors.New("The field " + name + " does not exist.")
+	}
+
+	if !sibling.IsValid() || null(sibling.Interface()) {
+		return time.Time{}, false, nil
+	}
+
+	t, err := timeValue(sibling.Interface())
+
+	return t, err == nil, err
+}
+
+// Look up a field of parent by its Go name, falling back to its json tag.
+func siblingField(parent reflect.Value, name string) (reflect.Value, bool) {
+
+	if !parent.IsValid() {
+		return parent, false
+	}
+
+	if field, ok := parent.Type().FieldByName(name); ok && field.PkgPath == "" {
+		// A field promoted through a nil embedded pointer exists but has
+		// no value, which is returned as the zero Value.
+		value, err := parent.FieldByIndexErr(field.Index)
+		if err != nil {
+			return reflect.Value{}, true
+		}
+		return value, true
+	}
+
+	for i := 0; i < parent.NumField(); i++ {
+		if tag, _ := fieldName(parent.Type().Field(i), []string{"json"}); tag == name {
+			return parent.Field(i), true
+		}
+	}
+
+	return parent, false
+}
+
+// Read a time.Time or a string in one of timeLayouts through any pointers.
+func timeValue(value interface{}) (time.Time, error) {
+
+	data := indirectValue(value)
+
+	if data.IsValid() && data.Type() == timeType {
+		return data.Interface().(time.Time), nil
+	}
+
+	if data.Kind() == reflect.String {
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, data.String()); err == nil {
+				return t, nil
+			}
+		}
+	}
+
+	return time.Time{}, errors.New("The value passed in could not be converted to a time.")
+}
+
+// Read a time.Duration or a duration string through any pointers.
+func durationValue(value interface{}) (time.Duration, error) {
+
+	data := indirectValue(value)
+
+	if data.IsValid() && data.Type() == durationType {
+		return time.Duration(data.Int()), nil
+	}
+
+	if data.Kind() == reflect.String {
+		if d, err := time.ParseDuration(data.String()); err == nil {
+			return d, nil
+		}
+	}
+
+	return 0, errors.New("The value passed in could not be converted to a duration.")
+}
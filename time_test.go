@@ -0,0 +1,156 @@
+package val
+
+import (
+	"testing"
+	"time"
+)
+
+// Fix the clock for the length of a test.
+func clockFactory(t *testing.T, now time.Time) {
+	Now = func() time.Time { return now }
+	t.Cleanup(func() { Now = time.Now })
+}
+
+func TestDateFormat(t *testing.T) {
+
+	var testDate struct {
+		Birthday *string `json:"birthday" validate:"date_format:2006-01-02"`
+		Opens    *string `json:"opens" validate:"date_format:15:04"`
+	}
+
+	if err := Bind(jsonFactory(`{"birthday": "1990-07-21", "opens": "09:30"}`), &testDate); err != nil {
+		t.Error(err)
+	}
+
+	errs, ok := Bind(jsonFactory(`{"birthday": "21/07/1990", "opens": "9am"}`), &testDate).(Errors)
+	if !ok || len(errs) != 2 {
+		t.Errorf("Both dates are in the wrong format but got %v.", errs)
+	}
+}
+
+func TestBeforeAfter(t *testing.T) {
+
+	clockFactory(t, time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	type testEvent struct {
+		Starts  *time.Time `json:"starts" validate:"after:now|before:+720h"`
+		Ends    *time.Time `json:"ends" validate:"after_field:Starts"`
+		Cutoff  *string    `json:"cutoff" validate:"before_field:ends"`
+		Created *string    `json:"created" validate:"after:2016-01-01|before:now"`
+	}
+
+	var test testEvent
+
+	body := `{"starts": "2016-06-02T00:00:00Z", "ends": "2016-06-03T00:00:00Z", "cutoff": "2016-06-02", "created": "2016-05-01"}`
+
+	if err := Bind(jsonFactory(body), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testEvent
+
+	body = `{"starts": "2016-05-30T00:00:00Z", "ends": "2016-05-29T00:00:00Z", "cutoff": "2016-06-01", "created": "2016-06-02"}`
+
+	errs, ok := Bind(jsonFactory(body), &test2).(Errors)
+	if !ok || len(errs) != 4 {
+		t.Errorf("Every field is out of order and should fail but got %v.", errs)
+	}
+
+	var test3 testEvent
+
+	if err := Bind(jsonFactory(`{"starts": "2016-08-01T00:00:00Z"}`), &test3); err == nil {
+		t.Error("A start two months away should fail before:+720h.")
+	}
+}
+
+type testSchedule struct {
+	Start *time.Time `json:"start"`
+}
+
+func TestAfterFieldNilEmbedded(t *testing.T) {
+
+	type testEvent struct {
+		*testSchedule
+		End *time.Time `json:"end" validate:"after_field:Start"`
+	}
+
+	end := time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := Validate(&testEvent{End: &end}); err != nil {
+		t.Errorf("A sibling behind a nil embedded pointer should count as not set but got %v.", err)
+	}
+
+	start := end.Add(time.Hour)
+
+	if err := Validate(&testEvent{testSchedule: &testSchedule{Start: &start}, End: &end}); err == nil {
+		t.Error("An end before the embedded start should fail after_field.")
+	}
+}
+
+func TestParseMoment(t *testing.T) {
+
+	now := time.Date(2016, 6, 1, 12, 0, 0, 0, time.UTC)
+	clockFactory(t, now)
+
+	moments := map[string]time.Time{
+		"now":                  now,
+		"+24h":                 now.Add(24 * time.Hour),
+		"now-1h30m":            now.Add(-90 * time.Minute),
+		"2016-01-02":           time.Date(2016, 1, 2, 0, 0, 0, 0, time.UTC),
+		"2016-01-02T15:04:05Z": time.Date(2016, 1, 2, 15, 4, 5, 0, time.UTC),
+	}
+
+	for moment, expected := range moments {
+		if parsed, err := parseMoment(moment); err != nil || !parsed.Equal(expected) {
+			t.Errorf("parseMoment(%q) returned %v, %v but expected %v.", moment, parsed, err, expected)
+		}
+	}
+
+	if _, err := parseMoment("tomorrow"); err == nil {
+		t.Error("parseMoment should not understand tomorrow.")
+	}
+}
+
+func TestDuration(t *testing.T) {
+
+	type testTimeout struct {
+		Timeout  *string       `json:"timeout" validate:"duration|min_duration:1s|max_duration:1m"`
+		Interval time.Duration `json:"interval" validate:"duration|min_duration:100ms"`
+	}
+
+	var test testTimeout
+
+	if err := Bind(jsonFactory(`{"timeout": "30s", "interval": 1000000000}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	tests := []string{
+		`{"timeout": "soon", "interval": 1000000000}`,
+		`{"timeout": "500ms", "interval": 1000000000}`,
+		`{"timeout": "2m", "interval": 1000000000}`,
+		`{"timeout": "30s", "interval": 1000}`,
+	}
+
+	for _, body := range tests {
+		var test testTimeout
+
+		if errs, ok := Bind(jsonFactory(body), &test).(Errors); !ok || len(errs) != 1 {
+			t.Errorf("%s should fail a single rule but got %v.", body, errs)
+		}
+	}
+}
+
+func TestDurationKinds(t *testing.T) {
+
+	interval := 5 * time.Second
+
+	if err := check(testField, "duration", &interval, interval, testParent); err != nil {
+		t.Error(err)
+	}
+
+	count := 5
+
+	if err := check(testField, "duration", &count, count, testParent); err == nil {
+		t.Error("A plain int should not pass duration.")
+	}
+}
@@ -0,0 +1,156 @@
+//go:build toml
+
+package val
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// BindTOML decodes a TOML document in to obj with github.com/BurntSushi/toml
+// and then validates it. Field names in errors are taken from the toml tag
+// and each error carries the line of the offending key, or of the table it is
+// missing from. Only built with the toml build tag so the core package has no
+// dependencies.
+//
+//	var Config struct {
+//	    Database struct {
+//	        Host *string `toml:"host" validate:"required"`
+//	        Port *int    `toml:"port" validate:"min:1|max:65535"`
+//	    } `toml:"database"`
+//	}
+//
+//	err := val.BindTOML(file, &Config)
+func BindTOML(input io.Reader, obj interface{}) error {
+
+	b, err := ioutil.ReadAll(input)
+	if err != nil {
+		return err
+	}
+
+	if _, err := toml.Decode(string(b), obj); err != nil {
+		return err
+	}
+
+	value, err := indirect(obj)
+	if err != nil {
+		return err
+	}
+
+	errs := validateStruct(value, "", []string{"toml"})
+	lines := tomlLines(string(b))
+
+	for _, err := range errs {
+		err.Line = tomlLine(lines, err.Field)
+	}
+
+	return errs.err()
+}
+
+// Find the line of the key at path, falling back to the closest parent
+// table. Keys are matched without case just like the decoder does.
+func tomlLine(lines map[string]int, path string) int {
+
+	path = strings.ToLower(path)
+
+	for path != "" {
+		if line, ok := lines[path]; ok {
+			return line
+		}
+
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+
+	return 0
+}
+
+// Scan a TOML document for the line every key and table is first defined on.
+// Keys are stored as lower cased dotted paths. This does not need to be a full
+// parser since the decoder has already accepted the document.
+func tomlLines(document string) map[string]int {
+
+	lines := make(map[string]int)
+	table := ""
+	multiline := ""
+
+	for i, line := range strings.Split(document, "\n") {
+
+		text := strings.TrimSpace(line)
+
+		// Skip over the body of multi-line strings.
+		if multiline != "" {
+			if strings.Contains(text, multiline) {
+				multiline = ""
+			}
+			continue
+		}
+
+		switch {
+		case text == "" || text[0] == '#':
+			continue
+		case strings.HasPrefix(text, "[[") && strings.Contains(text, "]]"):
+			table = tomlKey(text[2:strings.Index(text, "]]")])
+		case text[0] == '[' && strings.Contains(text, "]"):
+			table = tomlKey(text[1:strings.Index(text, "]")])
+		default:
+			eq := strings.Index(text, "=")
+			if eq < 0 {
+				continue
+			}
+
+			key := joinPath(table, tomlKey(text[:eq]))
+			if _, ok := lines[key]; !ok {
+				lines[key] = i + 1
+			}
+
+			value := strings.TrimSpace(text[eq+1:])
+			for _, delim := range []string{`"""`, `'''`} {
+				if strings.HasPrefix(value, delim) && !strings.Contains(value[3:], delim) {
+					multiline = delim
+				}
+			}
+			continue
+		}
+
+		if _, ok := lines[table]; !ok {
+			lines[table] = i + 1
+		}
+	}
+
+	return lines
+}
+
+// Normalise a possibly dotted and quoted TOML key in to a lower cased path.
+func tomlKey(key string) string {
+
+	var parts []string
+	var current strings.Builder
+	quote := rune(0)
+
+	for _, r := range key {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '.':
+			parts = append(parts, strings.TrimSpace(current.String()))
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	parts = append(parts, strings.TrimSpace(current.String()))
+
+	return strings.ToLower(strings.Join(parts, "."))
+}
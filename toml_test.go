@@ -0,0 +1,65 @@
+//go:build toml
+
+package val
+
+import (
+	"strings"
+	"testing"
+)
+
+type testTOMLConfig struct {
+	Name     *string `toml:"name" validate:"required"`
+	Database struct {
+		Host *string `toml:"host" validate:"required"`
+		Port *int    `toml:"port" validate:"min:1|max:65535"`
+	} `toml:"database"`
+}
+
+func TestBindTOML(t *testing.T) {
+
+	var testConfig testTOMLConfig
+
+	document := "name = \"api\"\n\n[database]\nhost = \"localhost\"\nport = 5432\n"
+
+	if err := BindTOML(strings.NewReader(document), &testConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testConfig.Name != "api" || *testConfig.Database.Port != 5432 {
+		t.Error("TOML document was not decoded in to the struct.")
+	}
+
+	var testConfig2 testTOMLConfig
+
+	document = "name = \"api\"\ndescription = '''\nport = 1\n'''\n\n[database]\n# the port\nport = 70000\n"
+
+	errs, ok := BindTOML(strings.NewReader(document), &testConfig2).(Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected errors for host and port but got %v.", errs)
+	}
+
+	// Host is missing so it points at the database table.
+	if errs[0].Field != "database.host" || errs[0].Line != 6 {
+		t.Errorf("Expected database.host on line 6 but got %v.", errs[0])
+	}
+
+	if errs[1].Field != "database.port" || errs[1].Line != 8 {
+		t.Errorf("Expected database.port on line 8 but got %v.", errs[1])
+	}
+}
+
+func TestTOMLKey(t *testing.T) {
+
+	keys := map[string]string{
+		"port":              "port",
+		" Database.Port ":   "database.port",
+		`"a.b".c`:           "a.b.c",
+		`servers . 'alpha'`: "servers.alpha",
+	}
+
+	for key, expected := range keys {
+		if path := tomlKey(key); path != expected {
+			t.Errorf("tomlKey(%q) returned %q but expected %q.", key, path, expected)
+		}
+	}
+}
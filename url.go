@@ -0,0 +1,61 @@
+package val
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Check that a string is an absolute URL using net/url. Options can be passed
+// after a colon, e.g. url:https,host,no_credentials.
+//
+//	host            require a host, ruling out URLs such as mailto:m@example.com
+//	no_credentials  reject URLs with a user name or password in them
+//
+// Anything else is taken as an allowed scheme, when none are given any
+// scheme is allowed.
+func url_rule(field string, value interface{}) error {
+
+	var options []string
+	if i := strings.Index(field, ":"); i >= 0 {
+		options = strings.Split(field[i+1:], ",")
+	}
+
+	data, ok := stringValue(value)
+	if !ok {
+		return errors.New("The value passed in for URL could not be converted to a string.")
+	}
+
+	// An empty string is left for required or filled to deal with.
+	if data == "" {
+		return nil
+	}
+
+	u, err := url.Parse(data)
+	if err != nil || u.Scheme == "" {
+		return errors.New("The value passed in was not a valid URL.")
+	}
+
+	var schemes []string
+
+	for _, option := range options {
+		switch option {
+		case "host":
+			if u.Hostname() == "" {
+				return errors.New("The URL passed in did not include a host.")
+			}
+		case "no_credentials":
+			if u.User != nil {
+				return errors.New("The URL passed in should not include credentials.")
+			}
+		default:
+			schemes = append(schemes, option)
+		}
+	}
+
+	if len(schemes) > 0 && !hasFlag(schemes, strings.ToLower(u.Scheme)) {
+		return errors.New("The URL passed in used the " + u.Scheme + " scheme which is not allowed.")
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ Val requires a structure to use pointers for validation. This may seem odd but i
 not used you will run into some strange issues since json.Decode() will pass an int type back 
 set as 0 giving no way to tell if a 0 was actually passed in or not. Using a pointer allows to
 check for a nil value before doing the validation and lets you have optional json parameters.
+Val requires Go 1.23 or newer.
 
 Basic Struct Example.
 
@@ -30,163 +31,569 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"io/ioutil"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
+// Returned by Bind when the body is empty or an empty JSON object.
+var errNothingPassed = errors.New("Nothing was passed in or JSON featured an empty object.")
+
 // Unpack JSON and call the validate function if no errors are found when unpacking it.
 // Bind kicks of the validation process. Note that Request.Body impliments an io.ReadCloser.
 // Look into ReadAll http://jmoiron.net/blog/crossing-streams-a-love-letter-to-ioreader/
-func Bind(input io.ReadCloser, obj interface{}) error {
+// Options such as DisallowUnknownFields and MaxBytes make decoding stricter.
+func Bind(input io.ReadCloser, obj interface{}, opts ...Option) error {
+
+	o := newOptions(opts)
+
+	if err := decodeJSON(input, obj, o); err != nil {
+		return err
+	}
+
+	return validateItems(obj, o)
+}
+
+// Unpack JSON in to obj without validating it.
+func decodeJSON(input io.Reader, obj interface{}, o options) error {
+
 	// Don't go through any logic if nothing was passed in.
-	if b, err := ioutil.ReadAll(input); err == nil && string(b) != "{}" && string(b) != "" {
+	if b, err := readBody(input, o); err == nil && string(b) != "{}" && string(b) != "" {
 		// Turn our string back into a io.Reader if it's valid
 		decoder := json.NewDecoder(bytes.NewReader(b))
 
-		if err := decoder.Decode(obj); err == nil {
-			return Validate(obj)
-		} else {
-			return err
+		if o.disallowUnknownFields {
+			decoder.DisallowUnknownFields()
 		}
+
+		return decoder.Decode(obj)
 	} else if err == nil {
-		return errors.New("Nothing was passed in or JSON featured an empty object.")
+		return errNothingPassed
 	} else {
 		return err
 	}
 }
 
+// Validate a decoded body, checking the number of items first when
+// it is an array and MinItems or MaxItems were passed.
+func validateItems(obj interface{}, o options) error {
+
+	var errs Errors
+
+	if value, err := deref(obj); err == nil && (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) {
+		if o.minItems > 0 && value.Len() < o.minItems {
+			errs = append(errs, &FieldError{Rule: "min_items:" + strconv.Itoa(o.minItems), Err: errors.New("Fewer items were passed in than the allowed minimum.")})
+		}
+
+		if o.maxItems > 0 && value.Len() > o.maxItems {
+			errs = append(errs, &FieldError{Rule: "max_items:" + strconv.Itoa(o.maxItems), Err: errors.New("More items were passed in than the allowed maximum.")})
+		}
+	}
+
+	err := Validate(obj)
+
+	if more, ok := err.(Errors); ok {
+		return append(errs, more...)
+	} else if err != nil {
+		return err
+	}
+
+	return errs.err()
+}
+
 // In version 1.0 I exported the Validation function. This can be used when you may
 // not need to or want to have JSON first converted into a struct.
+// Validate follows any number of pointers and interfaces to reach the struct and
+// returns an *InvalidInputError if it finds nil or anything other than a struct.
+// Slices and arrays of structs are also accepted, each element is validated and
+// errors are named by index, e.g. [3].email.
+// Every field is checked and the failures are returned together as Errors,
+// one entry per field. A nil field that is not required is skipped.
 func Validate(obj interface{}) error {
 
-	typ := reflect.TypeOf(obj)
+	value, err := deref(obj)
+	if err != nil {
+		return err
+	}
+
+	tags := []string{"json"}
+
+	switch {
+	case value.Kind() == reflect.Struct:
+		return validateStruct(value, "", tags).err()
+	case structElements(value.Type()):
+		return validateSlice(value, "", tags).err()
+	}
+
+	return &InvalidInputError{Kind: value.Kind(), Type: value.Type()}
+}
+
+// Walk down pointers and interfaces until we reach the struct we
+// are meant to fill in or validate.
+func indirect(obj interface{}) (reflect.Value, error) {
+
+	value, err := deref(obj)
+	if err != nil {
+		return value, err
+	}
+
+	if value.Kind() != reflect.Struct {
+		return value, &InvalidInputError{Kind: value.Kind(), Type: value.Type()}
+	}
+
+	return value, nil
+}
+
+// Walk down pointers and interfaces until we reach a value that is
+// neither. Nil at any level is reported as an error.
+func deref(obj interface{}) (reflect.Value, error) {
+
 	value := reflect.ValueOf(obj)
 
-	// Check to ensure we are getting a valid
-	// pointer for manipulation.
-	if typ.Kind() == reflect.Ptr {
-		typ = typ.Elem()
+	if !value.IsValid() {
+		return value, &InvalidInputError{}
+	}
+
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return value, &InvalidInputError{Kind: value.Kind(), Type: value.Type()}
+		}
 		value = value.Elem()
 	}
 
-	// Kill process if obj did not pass in a scruct.
-	// This happens when a pointer passed in.
-	if value.Kind() != reflect.Struct {
-		return nil
+	return value, nil
+}
+
+// Report whether typ is a slice or array holding structs or pointers to them.
+func structElements(typ reflect.Type) bool {
+
+	if typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array {
+		return false
+	}
+
+	return indirectType(typ.Elem()).Kind() == reflect.Struct
+}
+
+// Validate every struct held by a slice or array, naming each by its index.
+func validateSlice(value reflect.Value, path string, tags []string) Errors {
+
+	var errs Errors
+
+	for i := 0; i < value.Len(); i++ {
+		if nested, ok := nestedStruct(value.Index(i)); ok {
+			errs = append(errs, validateStruct(nested, path+"["+strconv.Itoa(i)+"]", tags)...)
+		}
 	}
 
-	for i := 0; i < typ.NumField(); i++ {
+	return errs
+}
+
+// Run every assertion found on the fields of the passed in struct value. Field
+// names in the returned errors come from the first of tags set on each field
+// and are joined onto path so nested structs report where the problem is.
+// The fields and their rules are looked up once per type, see planFor.
+func validateStruct(value reflect.Value, path string, tags []string) Errors {
+
+	var errs Errors
+
+	for _, plan := range planFor(value.Type(), tags) {
 
-		field := typ.Field(i)
-		fieldValue := value.Field(i).Interface()
-		zero := reflect.Zero(field.Type).Interface()
+		field := value.Field(plan.index)
+
+		if plan.optional {
+			errs = append(errs, validateOptional(field, plan, path, tags, value)...)
+			continue
+		}
+
+		fieldValue := field.Interface()
 
 		// Validate nested and embedded structs (if pointer, only do so if not nil)
-		if field.Type.Kind() == reflect.Struct ||
-			(field.Type.Kind() == reflect.Ptr && !reflect.DeepEqual(zero, fieldValue)) {
-			if err := Validate(fieldValue); err != nil {
-				return err
+		if nested, ok := nestedStruct(field); ok {
+			if plan.flatten {
+				errs = append(errs, validateStruct(nested, path, tags)...)
+			} else {
+				errs = append(errs, validateStruct(nested, joinPath(path, plan.name), tags)...)
+			}
+		} else if plan.elements {
+			if nested := reflect.Indirect(field); nested.IsValid() {
+				errs = append(errs, validateSlice(nested, joinPath(path, plan.name), tags)...)
 			}
 		}
 
-		if field.Tag.Get("validate") != "" || field.Tag.Get("binding") != "" {
-			// Break validate field into array
-			array := strings.Split(field.Tag.Get("validate"), "|")
+		// Do the hard work of checking all assertions, stopping at the
+		// first one that fails for this field.
+		for _, match := range plan.rules {
 
-			// Legacy Support for binding.
-			if array[0] == "" {
-				array = strings.Split(field.Tag.Get("binding"), "|")
+			//Check that value was passed in and is not required.
+			if !presence(match) && null(fieldValue) == true {
+				break
 			}
 
-			// Do the hard work of checking all assertions
-			for setting := range array {
+			if err := check(plan.field, match, pointerTo(field), fieldValue, value); err != nil {
+				errs = append(errs, &FieldError{Field: joinPath(path, plan.name), Rule: match, Err: err})
+				break
+			}
+		}
+	}
 
-				match := array[setting]
+	return errs
+}
 
-				//Check that value was passed in and is not required.
-				if match != "required" && null(fieldValue) == true {
-					return nil
-				}
+// Break the validate tag of a field into the assertions it lists.
+func rules(field reflect.StructField) []string {
 
-				switch {
-				case "required" == match:
-					if err := required(field, fieldValue, zero); err != nil {
-						return err
-					}
-				case "email" == match:
-					if err := regex(`regex:^[a-zA-Z0-9_.+-]+@[a-zA-Z0-9-]+\.[a-zA-Z0-9-.]+$`, fieldValue); err != nil {
-						return err
-					}
-				case "url" == match:
-					if err := regex(`regex:/^(https?:\/\/)?([\da-z\.-]+)\.([a-z\.]{2,6})([\/\w \.-]*)*\/?$/`, fieldValue); err != nil {
-						return err
-					}
-				case "alpha" == match:
-					if err := regex(`regex:\p{L}`, fieldValue); err != nil {
-						return err
-					}
-				case "alphadash" == match:
-					if err := regex(`regex:^[a-zA-Z0-9_]*$`, fieldValue); err != nil {
-						return err
-					}
-				case "alphanumeric" == match:
-					if err := regex(`regex:/[0-9a-zA-Z]/`, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "min:"):
-					if err := min(match, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "max:"):
-					if err := max(match, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "in:"):
-					if err := in(match, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "regex:"):
-					if err := regex(match, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "length:"):
-					if err := length(match, fieldValue); err != nil {
-						return err
-					}
-				case strings.HasPrefix(match, "length_between:"):
-					if err := length_between(match, fieldValue); err != nil {
-						return err
-					}
-				default:
-					panic("The field " + match + " is not a valid validation check.")
-				}
-			}
+	tag := field.Tag.Get("validate")
+
+	// Legacy Support for binding.
+	if tag == "" {
+		tag = field.Tag.Get("binding")
+	}
+
+	if tag == "" {
+		return nil
+	}
+
+	return splitEscaped(tag, '|')
+}
+
+// Split the parameters of a rule such as in:a,b,c on their commas.
+// A comma or pipe that is part of a value is written as \, or \|.
+func params(field string) []string {
+	return splitEscaped(field[strings.Index(field, ":")+1:], ',')
+}
+
+// Split s on sep unless it is escaped with a backslash, dropping the
+// backslash from escaped separators. Other backslashes are left alone so
+// patterns such as regex:\d+ keep working.
+func splitEscaped(s string, sep byte) []string {
+
+	var parts []string
+	var part strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == sep:
+			part.WriteByte(sep)
+			i++
+		case s[i] == sep:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(s[i])
 		}
 	}
 
-	return nil
+	return append(parts, part.String())
+}
+
+// Run a single assertion against a field. Value is always a pointer to the
+// field so rules only need to handle pointer types while original is the
+// field as it was found, which required checks for nil or the zero value.
+// Parent is the struct holding the field for rules that compare fields.
+func check(field reflect.StructField, match string, value, original interface{}, parent reflect.Value) error {
+
+	switch {
+	case presence(match):
+		return required(field, match, original)
+	case "nullable" == match:
+		return nil
+	case "email" == match || strings.HasPrefix(match, "email:"):
+		return email(match, value)
+	case "url" == match || strings.HasPrefix(match, "url:"):
+		return url_rule(match, value)
+	case charsets[match] != nil:
+		return charset(match, value)
+	case "filled" == match || "not_empty" == match:
+		return filled(match, value)
+	case strings.HasPrefix(match, "gt:"):
+		return gt(match, value)
+	case strings.HasPrefix(match, "gte:"):
+		return gte(match, value)
+	case strings.HasPrefix(match, "lt:"):
+		return lt(match, value)
+	case strings.HasPrefix(match, "lte:"):
+		return lte(match, value)
+	case strings.HasPrefix(match, "between:"):
+		return between(match, value)
+	case "positive" == match:
+		return positive(match, value)
+	case "negative" == match:
+		return negative(match, value)
+	case "nonzero" == match:
+		return nonzero(match, value)
+	case strings.HasPrefix(match, "multiple_of:"):
+		return multiple_of(match, value)
+	case "integer" == match:
+		return integer(match, value)
+	case strings.HasPrefix(match, "min:"):
+		return min(match, value)
+	case strings.HasPrefix(match, "max:"):
+		return max(match, value)
+	case "unique" == match || strings.HasPrefix(match, "unique:"):
+		return unique(match, value)
+	case "sorted" == match:
+		return sorted(match, value)
+	case "sorted_desc" == match:
+		return sorted_desc(match, value)
+	case strings.HasPrefix(match, "subset_of:"):
+		return subset_of(match, value)
+	case "enum" == match:
+		return enum(match, value)
+	case strings.HasPrefix(match, "in_fold:"):
+		return in_fold(match, value)
+	case strings.HasPrefix(match, "not_in:") || strings.HasPrefix(match, "not_in_fold:"):
+		return not_in(match, value)
+	case strings.HasPrefix(match, "contains:") || strings.HasPrefix(match, "contains_fold:"):
+		return contains(match, value)
+	case strings.HasPrefix(match, "contains_any:") || strings.HasPrefix(match, "contains_any_fold:"):
+		return contains_any(match, value)
+	case strings.HasPrefix(match, "excludes:") || strings.HasPrefix(match, "excludes_fold:"):
+		return excludes(match, value)
+	case strings.HasPrefix(match, "starts_with:") || strings.HasPrefix(match, "starts_with_fold:"):
+		return starts_with(match, value)
+	case strings.HasPrefix(match, "ends_with:") || strings.HasPrefix(match, "ends_with_fold:"):
+		return ends_with(match, value)
+	case strings.HasPrefix(match, "in:"):
+		return in(match, value)
+	case strings.HasPrefix(match, "regex:"):
+		return regex(match, value)
+	case strings.HasPrefix(match, "length:"):
+		return length(match, value)
+	case strings.HasPrefix(match, "length_bytes:"):
+		return length_bytes(match, value)
+	case strings.HasPrefix(match, "length_graphemes:"):
+		return length_graphemes(match, value)
+	case strings.HasPrefix(match, "min_length:"):
+		return min_length(match, value)
+	case strings.HasPrefix(match, "max_length:"):
+		return max_length(match, value)
+	case strings.HasPrefix(match, "length_between:"):
+		return length_between(match, value)
+	case strings.HasPrefix(match, "max_size:"):
+		return max_size(match, value)
+	case strings.HasPrefix(match, "mime:"):
+		return mime_type(match, value)
+	case strings.HasPrefix(match, "date_format:"):
+		return date_format(match, value)
+	case strings.HasPrefix(match, "before:"):
+		return before(match, value)
+	case strings.HasPrefix(match, "after:"):
+		return after(match, value)
+	case strings.HasPrefix(match, "before_field:"):
+		return before_field(match, value, parent)
+	case strings.HasPrefix(match, "after_field:"):
+		return after_field(match, value, parent)
+	case "duration" == match:
+		return duration(match, value)
+	case strings.HasPrefix(match, "min_duration:"):
+		return min_duration(match, value)
+	case strings.HasPrefix(match, "max_duration:"):
+		return max_duration(match, value)
+	case "ip" == match:
+		return ip(match, value, 0)
+	case "ipv4" == match:
+		return ip(match, value, 4)
+	case "ipv6" == match:
+		return ip(match, value, 6)
+	case "cidr" == match:
+		return cidr(match, value)
+	case "mac" == match:
+		return mac(match, value)
+	case "hostname" == match:
+		return hostname(match, value)
+	case "fqdn" == match:
+		return fqdn(match, value)
+	case "port" == match:
+		return port(match, value)
+	case "hostport" == match:
+		return hostport(match, value)
+	case "uuid" == match || strings.HasPrefix(match, "uuid:"):
+		return uuid(match, value)
+	case "ulid" == match:
+		return ulid(match, value)
+	case "semver" == match:
+		return semver(match, value)
+	case "slug" == match:
+		return slug(match, value)
+	case "hexadecimal" == match:
+		return hexadecimal(match, value)
+	case "hexcolor" == match:
+		return hexcolor(match, value)
+	case "base64" == match:
+		return base64_rule(match, value)
+	case "base64url" == match:
+		return base64url(match, value)
+	case "jwt" == match:
+		return jwt(match, value)
+	case "luhn" == match:
+		return luhn(match, value)
+	case "credit_card" == match:
+		return credit_card(match, value)
+	case "iban" == match:
+		return iban(match, value)
+	case "bic" == match:
+		return bic(match, value)
+	case "isbn10" == match:
+		return isbn10(match, value)
+	case "isbn13" == match:
+		return isbn13(match, value)
+	case "iso4217" == match:
+		return iso4217(match, value)
+	case strings.HasPrefix(match, "decimal:"):
+		return decimal(match, value)
+	case "iso3166_alpha2" == match:
+		return iso3166_alpha2(match, value)
+	case "iso3166_alpha3" == match:
+		return iso3166_alpha3(match, value)
+	case "bcp47" == match:
+		return bcp47(match, value)
+	case "timezone" == match:
+		return timezone(match, value)
+	case "latitude" == match:
+		return latitude(match, value)
+	case "longitude" == match:
+		return longitude(match, value)
+	case "e164" == match:
+		return e164(match, value)
+	default:
+		panic("The field " + match + " is not a valid validation check.")
+	}
+}
+
+// Rules that check whether a value was sent rather than what it is. Outside of
+// an Optional a nil pointer can not say if it was left out or sent as null so
+// present and not_null both act like required.
+func presence(match string) bool {
+	return match == "required" || match == "required:allow_zero" || match == "present" || match == "not_null"
+}
+
+// Return a pointer to the passed in field. Pointer fields are returned as is,
+// anything else has its address taken (or is copied when that is not possible).
+func pointerTo(value reflect.Value) interface{} {
+
+	if value.Kind() == reflect.Ptr {
+		return value.Interface()
+	}
+
+	if value.CanAddr() {
+		return value.Addr().Interface()
+	}
+
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+
+	return ptr.Interface()
+}
+
+// Name a field using the first of the passed in tags that is set on it,
+// falling back to the Go field name. Tagged reports whether a tag was used.
+func fieldName(field reflect.StructField, tags []string) (name string, tagged bool) {
+
+	for _, tag := range tags {
+		name := strings.Split(field.Tag.Get(tag), ",")[0]
+		if name != "" && name != "-" {
+			return name, true
+		}
+	}
+
+	return field.Name, false
+}
+
+// Fields bound from the headers or path of a request are named after where
+// they came from, such as header.X-Request-ID or path.id.
+func sourceName(field reflect.StructField) (string, bool) {
+
+	for _, source := range []string{"header", "path"} {
+		if name := field.Tag.Get(source); name != "" && name != "-" {
+			return source + "." + name, true
+		}
+	}
+
+	return "", false
+}
+
+// Follow any pointers held by value.
+func indirectValue(value interface{}) reflect.Value {
+
+	data := reflect.ValueOf(value)
+
+	for data.Kind() == reflect.Ptr && !data.IsNil() {
+		data = data.Elem()
+	}
+
+	return data
+}
+
+// Read a string, or a named string type, through any pointers.
+func stringValue(value interface{}) (string, bool) {
+
+	data := indirectValue(value)
+
+	if data.Kind() != reflect.String {
+		return "", false
+	}
+
+	return data.String(), true
+}
+
+// Join a field name onto the path of the struct it belongs to.
+func joinPath(path, name string) string {
+
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// Return the struct held by a field if it is a struct or a non-nil
+// pointer to one so that it can be validated as well.
+func nestedStruct(value reflect.Value) (reflect.Value, bool) {
+
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return value, false
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return value, false
+	}
+
+	return value, true
 }
 
 // Ensure that the value being passed in is not of type nil.
 func null(value interface{}) bool {
-	if reflect.ValueOf(value).IsNil() {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	case reflect.Invalid:
 		return true
 	}
 
 	return false
 }
 
-// Check that the following function features
-// the required field. May need to check for
-// more special cases like since passing in null
-// is the same as 0 for int type checking.
-func required(field reflect.StructField, value, zero interface{}) error {
+// Check that the following function features the required field. Pointers,
+// interfaces, maps and slices only need to be non-nil so false, 0 or "" behind
+// a pointer pass, use filled as well to reject empty strings and lists. Other
+// kinds always hold something so they must not be their zero value, unless
+// the rule is required:allow_zero.
+func required(field reflect.StructField, match string, value interface{}) error {
 
-	if reflect.DeepEqual(zero, value) {
-		if _, ok := value.(int); !ok {
+	switch field.Type.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		if null(value) {
+			return errors.New("The required field " + field.Name + " was not submitted.")
+		}
+	default:
+		if match != "required:allow_zero" && reflect.ValueOf(value).IsZero() {
 			return errors.New("The required field " + field.Name + " was not submitted.")
 		}
 	}
@@ -194,29 +601,48 @@ func required(field reflect.StructField, value, zero interface{}) error {
 	return nil
 }
 
-// Check that the passed in field is a valid email
-// Need to improve error logging for this method
-// Currently only supports strings, ints
-func in(field string, value interface{}) error {
+// Check that a string, slice, map or array is not empty, following any
+// pointers to get to it. Values of other kinds always pass.
+func filled(field string, value interface{}) error {
 
-	if data, ok := value.(*string); ok {
-		if len(*data) == 0 {
+	data := reflect.ValueOf(value)
+
+	for data.Kind() == reflect.Ptr || data.Kind() == reflect.Interface {
+		if data.IsNil() {
 			return nil
 		}
+		data = data.Elem()
+	}
 
-		valid := strings.Split(field[3:], ",")
-
-		for option := range valid {
-			if valid[option] == *data {
-				return nil
-			}
+	switch data.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if data.Len() == 0 {
+			return errors.New("The value passed in was empty.")
 		}
+	}
 
-	} else {
-		return errors.New("The value passed in for IN could not be converted to a string.")
+	return nil
+}
+
+// Check that the passed in field is one of the values listed.
+// Works with strings, ints and floats including named types, an empty
+// string is left for required to deal with.
+func in(field string, value interface{}) error {
+
+	if data, ok := stringValue(value); ok && len(data) == 0 {
+		return nil
+	}
+
+	found, ok := member(params(field), value, false)
+	if !ok {
+		return errors.New("The value passed in for IN could not be converted to a string or number.")
 	}
 
-	return errors.New("In did not match any of the expected values.")
+	if !found {
+		return errors.New("In did not match any of the expected values.")
+	}
+
+	return nil
 }
 
 func min(field string, value interface{}) error {
@@ -292,14 +718,15 @@ func match_regex(reg string, data []byte) error {
 }
 
 // Check passed in json length string is exact value passed in.
-// Also checks if passed in values is between two different ones.
+// The length is counted in characters (runes) so "日本語" has a length of 3,
+// use length_bytes or length_graphemes to count something else.
 func length(field string, value interface{}) error {
 
 	length := field[strings.Index(field, ":")+1:]
 
-	if data, ok := value.(*string); ok {
+	if data, ok := stringValue(value); ok {
 		if intdata, intok := strconv.Atoi(length); intok == nil {
-			if len(*data) == intdata {
+			if utf8.RuneCountInString(data) == intdata {
 				return nil
 			} else {
 				return errors.New("The data passed in was not equal to the expected length.")
@@ -313,6 +740,7 @@ func length(field string, value interface{}) error {
 }
 
 // Check if the strings length is between high,low.
+// Like length this counts characters (runes) rather than bytes.
 func length_between(field string, value interface{}) error {
 
 	length := field[strings.Index(field, ":")+1:]
@@ -320,13 +748,15 @@ func length_between(field string, value interface{}) error {
 
 	if len(vals) == 2 {
 
-		if data, ok := value.(*string); ok {
+		if data, ok := stringValue(value); ok {
+
+			size := utf8.RuneCountInString(data)
 
 			if lowerbound, lowok := strconv.Atoi(vals[0]); lowok == nil {
 
 				if upperbound, upok := strconv.Atoi(vals[1]); upok == nil {
 
-					if lowerbound <= len(*data) && upperbound >= len(*data) {
+					if lowerbound <= size && upperbound >= size {
 						return nil
 					} else {
 						return errors.New("The value passed in for LENGTH BETWEEN was not in bounds.")
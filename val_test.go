@@ -4,8 +4,10 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Make string into io.ReadCloser
@@ -428,3 +430,243 @@ func TestLengthBetween(t *testing.T) {
 	}
 
 }
+
+// Validate should refuse anything that is not a struct instead of
+// silently passing it.
+func TestValidateInvalidInput(t *testing.T) {
+
+	type testInput struct {
+		Test *string `json:"test" validate:"required"`
+	}
+
+	var nilPointer *testInput
+	var nilInterface interface{}
+	number := 5
+
+	invalid := []struct {
+		input interface{}
+		kind  reflect.Kind
+	}{
+		{nil, reflect.Invalid},
+		{nilPointer, reflect.Ptr},
+		{&nilPointer, reflect.Ptr},
+		{&nilInterface, reflect.Interface},
+		{number, reflect.Int},
+		{&number, reflect.Int},
+		{"string", reflect.String},
+		{[]int{1, 2}, reflect.Slice},
+	}
+
+	for _, test := range invalid {
+		err := Validate(test.input)
+
+		inputErr, ok := err.(*InvalidInputError)
+		if !ok {
+			t.Errorf("Validate(%#v) should have returned an InvalidInputError but returned %v.", test.input, err)
+			continue
+		}
+
+		if inputErr.Kind != test.kind {
+			t.Errorf("Validate(%#v) reported kind %v but expected %v.", test.input, inputErr.Kind, test.kind)
+		}
+	}
+}
+
+// Validate reports every failing field as Errors and a nil field that is
+// not required does not stop the fields after it from being checked.
+func TestValidateCollectsErrors(t *testing.T) {
+
+	type testInput struct {
+		Nickname *string `json:"nickname" validate:"length_between:2,5"`
+		Email    *string `json:"email" validate:"required|email"`
+		Age      *int    `json:"age" validate:"required|min:18"`
+	}
+
+	email, age := "michaeljs.edu", 12
+
+	errs, ok := Validate(&testInput{Email: &email, Age: &age}).(Errors)
+	if !ok || len(errs) != 2 || errs[0].Field != "email" || errs[1].Field != "age" {
+		t.Errorf("Both email and age should be reported after the nil nickname but got %v.", errs)
+	}
+}
+
+// Double pointers and interface wrapped structs should be followed
+// down to the struct and validated.
+func TestValidateIndirect(t *testing.T) {
+
+	type testInput struct {
+		Test *string `json:"test" validate:"required"`
+	}
+
+	empty := &testInput{}
+	var wrapped interface{} = testInput{}
+
+	if err := Validate(&empty); err == nil {
+		t.Error("Double pointer to a struct missing a required field should return error but did not.")
+	}
+
+	if err := Validate(&wrapped); err == nil {
+		t.Error("Interface wrapped struct missing a required field should return error but did not.")
+	}
+
+	value := "hello"
+	filled := &testInput{Test: &value}
+
+	if err := Validate(&filled); err != nil {
+		t.Error(err)
+	}
+
+	// Unexported fields and types like time.Time should not cause a panic.
+	var testTime struct {
+		When    time.Time `json:"when"`
+		private *string
+		Test    *string `json:"test" validate:"required"`
+	}
+	testTime.Test = &value
+
+	if err := Validate(&testTime); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindOptions(t *testing.T) {
+
+	var testStrict struct {
+		Test *string `json:"test" validate:"required"`
+	}
+
+	if err := Bind(jsonFactory(`{"test": "a", "other": 1}`), &testStrict); err != nil {
+		t.Error(err)
+	}
+
+	if err := Bind(jsonFactory(`{"test": "a", "other": 1}`), &testStrict, DisallowUnknownFields()); err == nil {
+		t.Error("Unknown field other should return an error when they are disallowed.")
+	}
+
+	if err := Bind(jsonFactory(`{"test": "a long string"}`), &testStrict, MaxBytes(10)); err != ErrTooLarge {
+		t.Errorf("A body over the limit should return ErrTooLarge but got %v.", err)
+	}
+}
+
+// Top level JSON arrays should have every element validated.
+func TestBindArray(t *testing.T) {
+
+	type testItem struct {
+		Name  *string `json:"name" validate:"required"`
+		Email *string `json:"email" validate:"email"`
+	}
+
+	var testItems []testItem
+
+	if err := Bind(jsonFactory(`[{"name": "a"}, {"name": "b", "email": "b@gmail.com"}]`), &testItems); err != nil {
+		t.Error(err)
+	}
+
+	var testItems2 []*testItem
+
+	errs, ok := Bind(jsonFactory(`[{"name": "a"}, {"email": "b@gmail.com"}, {"name": "c"}, {"name": "d", "email": "d"}]`), &testItems2).(Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected errors for items 1 and 3 but got %v.", errs)
+	}
+
+	if errs[0].Field != "[1].name" || errs[1].Field != "[3].email" {
+		t.Errorf("Errors should be indexed by position but got %v.", errs)
+	}
+
+	var testItems3 []testItem
+
+	errs, ok = Bind(jsonFactory(`[{"name": "a"}]`), &testItems3, MinItems(2)).(Errors)
+	if !ok || len(errs) != 1 || errs[0].Rule != "min_items:2" {
+		t.Errorf("A single item should fail MinItems(2) but got %v.", errs)
+	}
+
+	var testItems4 []testItem
+
+	if err := Bind(jsonFactory(`[{"name": "a"}, {"name": "b"}, {}]`), &testItems4, MaxItems(2)); err == nil {
+		t.Error("Three items should fail MaxItems(2).")
+	}
+
+	// Slices of structs inside a struct are validated as well.
+	var testNested struct {
+		Items []testItem `json:"items"`
+	}
+
+	errs, ok = Bind(jsonFactory(`{"items": [{"name": "a"}, {}]}`), &testNested).(Errors)
+	if !ok || len(errs) != 1 || errs[0].Field != "items[1].name" {
+		t.Errorf("Expected an error for items[1].name but got %v.", errs)
+	}
+}
+
+// Required means non-nil for pointers, maps and slices and non-zero
+// for everything else.
+func TestRequiredKinds(t *testing.T) {
+
+	type testKinds struct {
+		Int    int       `json:"int" validate:"required"`
+		Bool   *bool     `json:"bool" validate:"required"`
+		String *string   `json:"string" validate:"required"`
+		Slice  []string  `json:"slice" validate:"required"`
+		Map    *struct{} `json:"map" validate:"required"`
+	}
+
+	var test testKinds
+
+	if err := Bind(jsonFactory(`{"int": 1, "bool": false, "string": "", "slice": [], "map": {}}`), &test); err != nil {
+		t.Errorf("False, empty strings and empty slices are submitted values but got %v.", err)
+	}
+
+	var test2 testKinds
+
+	errs, ok := Bind(jsonFactory(`{"int": 0}`), &test2).(Errors)
+	if !ok || len(errs) != 5 {
+		t.Errorf("Every field should fail required but got %v.", errs)
+	}
+
+	type testAllowZero struct {
+		Int  int   `json:"int" validate:"required:allow_zero"`
+		Bool *bool `json:"bool" validate:"required:allow_zero"`
+	}
+
+	var test3 testAllowZero
+
+	if errs, ok := Bind(jsonFactory(`{"int": 0}`), &test3).(Errors); !ok || len(errs) != 1 || errs[0].Field != "bool" {
+		t.Errorf("With required:allow_zero an int of 0 should pass but got %v.", errs)
+	}
+}
+
+func TestFilled(t *testing.T) {
+
+	type testFilled struct {
+		String *string           `json:"string" validate:"filled"`
+		Slice  *[]string         `json:"slice" validate:"not_empty"`
+		Map    map[string]string `json:"map" validate:"filled"`
+		Plain  string            `json:"plain" validate:"filled"`
+	}
+
+	var test testFilled
+
+	if err := Bind(jsonFactory(`{"string": "a", "slice": ["a"], "map": {"a": "b"}, "plain": "a"}`), &test); err != nil {
+		t.Error(err)
+	}
+
+	var test2 testFilled
+
+	errs, ok := Bind(jsonFactory(`{"string": "", "slice": [], "map": {}}`), &test2).(Errors)
+	if !ok || len(errs) != 4 {
+		t.Errorf("Every empty value should fail filled but got %v.", errs)
+	}
+
+	// Filled on its own does not require the value to be sent.
+	var test3 struct {
+		String *string `json:"string" validate:"filled"`
+		Other  *string `json:"other"`
+	}
+
+	if err := Bind(jsonFactory(`{"other": "a"}`), &test3); err != nil {
+		t.Error(err)
+	}
+}
+
+// A field and parent for calling check directly in table tests.
+var testField = reflect.StructField{Name: "Test", Type: reflect.TypeOf("")}
+var testParent reflect.Value
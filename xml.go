@@ -0,0 +1,189 @@
+package val
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+)
+
+var xmlUnmarshalerType = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+
+// BindXML decodes an XML body in to obj with encoding/xml and then validates
+// it using the same validate tags as Bind. Field names in errors are taken from
+// the xml tag. DisallowUnknownFields rejects elements that do not map to a
+// field and MaxBytes limits the size of the body, just like they do for Bind.
+//
+//	var Order struct {
+//	    ID    *string `xml:"id" validate:"required"`
+//	    Total *int    `xml:"total" validate:"min:1"`
+//	}
+//
+//	err := val.BindXML(r.Body, &Order, val.DisallowUnknownFields(), val.MaxBytes(1<<20))
+func BindXML(input io.Reader, obj interface{}, opts ...Option) error {
+
+	value, err := decodeXML(input, obj, newOptions(opts))
+	if err != nil {
+		return err
+	}
+
+	return validateStruct(value, "", []string{"xml"}).err()
+}
+
+// Unpack XML in to obj without validating it, returning the struct it
+// was decoded in to.
+func decodeXML(input io.Reader, obj interface{}, o options) (reflect.Value, error) {
+
+	b, err := readBody(input, o)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if len(bytes.TrimSpace(b)) == 0 {
+		return reflect.Value{}, errors.New("Nothing was passed in.")
+	}
+
+	if err := xml.Unmarshal(b, obj); err != nil {
+		return reflect.Value{}, err
+	}
+
+	value, err := indirect(obj)
+	if err != nil {
+		return value, err
+	}
+
+	if o.disallowUnknownFields {
+		if err := unknownXML(b, value.Type()); err != nil {
+			return value, err
+		}
+	}
+
+	return value, nil
+}
+
+// Walk the elements of an XML document checking that every one of them maps
+// to a field of typ.
+func unknownXML(data []byte, typ reflect.Type) error {
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return checkXMLElement(decoder, typ, start.Name.Local)
+		}
+	}
+}
+
+// Check the children of the element the decoder is currently in against typ.
+func checkXMLElement(decoder *xml.Decoder, typ reflect.Type, path string) error {
+
+	children, open := xmlChildren(typ)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			child, ok := children[element.Name.Local]
+
+			switch {
+			case open || (ok && child == nil):
+				if err := decoder.Skip(); err != nil {
+					return err
+				}
+			case !ok:
+				return errors.New("The element " + path + ">" + element.Name.Local + " does not match any field.")
+			default:
+				if err := checkXMLElement(decoder, child, path+">"+element.Name.Local); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// Return the child elements a type accepts keyed by name. Open is true when
+// the type will take any element, such as when it has an ",any" field or is
+// not a struct at all. Elements mapped through a>b paths have a nil type and
+// their content is not checked.
+func xmlChildren(typ reflect.Type) (children map[string]reflect.Type, open bool) {
+
+	for typ.Kind() == reflect.Ptr || (typ.Kind() == reflect.Slice && typ.Elem().Kind() != reflect.Uint8) {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ == timeType ||
+		reflect.PtrTo(typ).Implements(xmlUnmarshalerType) || reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		return nil, true
+	}
+
+	children = make(map[string]reflect.Type)
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		field := typ.Field(i)
+		tag := field.Tag.Get("xml")
+
+		if field.PkgPath != "" || tag == "-" || field.Name == "XMLName" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name, flags := parts[0], parts[1:]
+
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			embedded, embeddedOpen := xmlChildren(field.Type)
+			for key, value := range embedded {
+				children[key] = value
+			}
+			open = open || embeddedOpen
+			continue
+		}
+
+		if hasFlag(flags, "any") || hasFlag(flags, "innerxml") {
+			open = true
+			continue
+		}
+
+		if hasFlag(flags, "attr") || hasFlag(flags, "chardata") || hasFlag(flags, "cdata") || hasFlag(flags, "comment") {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if i := strings.Index(name, ">"); i >= 0 {
+			children[name[:i]] = nil
+			continue
+		}
+
+		children[name] = field.Type
+	}
+
+	return children, open
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package val
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+type testXMLItem struct {
+	SKU      *string `xml:"sku,attr" validate:"required"`
+	Quantity *int    `xml:"quantity" validate:"min:1"`
+}
+
+type testXMLOrder struct {
+	XMLName xml.Name      `xml:"order"`
+	ID      *string       `xml:"id" validate:"required|length:4"`
+	Email   *string       `xml:"customer>email" validate:"email"`
+	Items   []testXMLItem `xml:"item"`
+}
+
+func TestBindXML(t *testing.T) {
+
+	var testOrder testXMLOrder
+
+	body := `<order><id>A100</id><customer><email>m@gmail.com</email></customer><item sku="x"><quantity>2</quantity></item></order>`
+
+	if err := BindXML(strings.NewReader(body), &testOrder, DisallowUnknownFields()); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testOrder.ID != "A100" || *testOrder.Email != "m@gmail.com" || len(testOrder.Items) != 1 {
+		t.Error("XML body was not decoded in to the struct.")
+	}
+
+	var testOrder2 testXMLOrder
+
+	errs, ok := BindXML(strings.NewReader(`<order><id>A1</id></order>`), &testOrder2).(Errors)
+	if !ok || len(errs) != 1 || errs[0].Field != "id" {
+		t.Errorf("Expected a single error for id but got %v.", errs)
+	}
+
+	var testOrder3 testXMLOrder
+
+	if err := BindXML(strings.NewReader(""), &testOrder3); err == nil {
+		t.Error("An empty body should return an error.")
+	}
+}
+
+func TestBindXMLStrict(t *testing.T) {
+
+	body := `<order><id>A100</id><item sku="x"><quantity>2</quantity><colour>red</colour></item></order>`
+
+	var testOrder testXMLOrder
+
+	if err := BindXML(strings.NewReader(body), &testOrder); err != nil {
+		t.Errorf("Unknown elements should be ignored by default but got %v.", err)
+	}
+
+	var testOrder2 testXMLOrder
+
+	if err := BindXML(strings.NewReader(body), &testOrder2, DisallowUnknownFields()); err == nil {
+		t.Error("The unknown colour element should return an error.")
+	}
+
+	var testOrder3 testXMLOrder
+
+	if err := BindXML(strings.NewReader(body), &testOrder3, MaxBytes(16)); err != ErrTooLarge {
+		t.Errorf("A body over the limit should return ErrTooLarge but got %v.", err)
+	}
+}
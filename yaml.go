@@ -0,0 +1,87 @@
+//go:build yaml
+
+package val
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BindYAML decodes a YAML document in to obj with gopkg.in/yaml.v3 and then
+// validates it. Field names in errors are taken from the yaml tag and each
+// error carries the line of the offending key, or of the mapping it is
+// missing from. Only built with the yaml build tag so the core package has
+// no dependencies.
+//
+//	var Config struct {
+//	    Database struct {
+//	        Host *string `yaml:"host" validate:"required"`
+//	        Port *int    `yaml:"port" validate:"min:1|max:65535"`
+//	    } `yaml:"database"`
+//	}
+//
+//	err := val.BindYAML(file, &Config)
+func BindYAML(input io.Reader, obj interface{}) error {
+
+	var root yaml.Node
+
+	if err := yaml.NewDecoder(input).Decode(&root); err == io.EOF {
+		return errors.New("Nothing was passed in.")
+	} else if err != nil {
+		return err
+	}
+
+	if err := root.Decode(obj); err != nil {
+		return err
+	}
+
+	value, err := indirect(obj)
+	if err != nil {
+		return err
+	}
+
+	errs := validateStruct(value, "", []string{"yaml"})
+
+	for _, err := range errs {
+		err.Line = yamlLine(&root, err.Field)
+	}
+
+	return errs.err()
+}
+
+// Find the line of the key at path, falling back to the closest parent that
+// exists. Keys are matched without case as yaml.v3 lower cases untagged fields.
+func yamlLine(node *yaml.Node, path string) int {
+
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line := node.Line
+
+	for _, key := range strings.Split(path, ".") {
+		if node.Kind != yaml.MappingNode {
+			break
+		}
+
+		found := false
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, key) {
+				line = node.Content[i].Line
+				node = node.Content[i+1]
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			break
+		}
+	}
+
+	return line
+}
@@ -0,0 +1,53 @@
+//go:build yaml
+
+package val
+
+import (
+	"strings"
+	"testing"
+)
+
+type testYAMLConfig struct {
+	Name     *string `yaml:"name" validate:"required"`
+	Database struct {
+		Host *string `yaml:"host" validate:"required"`
+		Port *int    `yaml:"port" validate:"min:1|max:65535"`
+	} `yaml:"database"`
+}
+
+func TestBindYAML(t *testing.T) {
+
+	var testConfig testYAMLConfig
+
+	document := "name: api\ndatabase:\n  host: localhost\n  port: 5432\n"
+
+	if err := BindYAML(strings.NewReader(document), &testConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testConfig.Name != "api" || *testConfig.Database.Port != 5432 {
+		t.Error("YAML document was not decoded in to the struct.")
+	}
+
+	var testConfig2 testYAMLConfig
+
+	document = "name: api\ndatabase:\n  port: 70000\n"
+
+	errs, ok := BindYAML(strings.NewReader(document), &testConfig2).(Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("Expected errors for host and port but got %v.", errs)
+	}
+
+	// Host is missing so it points at the database mapping.
+	if errs[0].Field != "database.host" || errs[0].Line != 2 {
+		t.Errorf("Expected database.host on line 2 but got %v.", errs[0])
+	}
+
+	if errs[1].Field != "database.port" || errs[1].Line != 3 {
+		t.Errorf("Expected database.port on line 3 but got %v.", errs[1])
+	}
+
+	if err := BindYAML(strings.NewReader(""), &testConfig2); err == nil {
+		t.Error("An empty document should return an error.")
+	}
+}